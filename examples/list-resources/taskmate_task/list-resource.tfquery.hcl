@@ -0,0 +1,9 @@
+list "taskmate_task" "pending_high" {
+  provider         = taskmate
+  include_resource = true
+
+  config {
+    status   = "pending"
+    priority = "high"
+  }
+}
@@ -32,7 +32,7 @@ variable "taskmate_token" {
 resource "taskmate_task" "deployment" {
   title       = "Deploy to Production"
   description = "Deploy v2.0 release"
-  due_date    = "2026-02-31"
+  due_date    = "2026-02-28"
   priority    = "high"
   status      = "pending"
 }
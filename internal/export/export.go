@@ -0,0 +1,261 @@
+// Package export writes existing TaskMate tasks out as Terraform
+// configuration, so they can be brought under management with import blocks.
+package export
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-taskmate/internal/provider"
+)
+
+const (
+	resourcesFile = "taskmate_tasks.tf"
+	importsFile   = "taskmate_imports.tf"
+)
+
+// filterFlag collects repeated --filter key=value arguments.
+type filterFlag struct {
+	filter provider.TaskFilter
+}
+
+func (f *filterFlag) String() string {
+	return ""
+}
+
+func (f *filterFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || val == "" {
+		return fmt.Errorf("filter %q must be key=value", value)
+	}
+
+	switch key {
+	case "status":
+		f.filter.Status = val
+	case "priority":
+		f.filter.Priority = val
+	case "title":
+		f.filter.Title = val
+	case "label":
+		f.filter.Labels = append(f.filter.Labels, val)
+	case "project_id":
+		id, err := strconv.Atoi(val)
+		if err != nil || id <= 0 {
+			return fmt.Errorf("filter %q: project_id must be a positive integer", value)
+		}
+		f.filter.ProjectID = id
+	case "assignee":
+		f.filter.Assignee = val
+	default:
+		return fmt.Errorf("unknown filter %q (expected status, priority, title, label, project_id or assignee)", key)
+	}
+
+	return nil
+}
+
+// Run implements the export subcommand. args excludes the subcommand name.
+func Run(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+
+	host := flags.String("host", envOr("TASKMATE_HOST", "http://localhost:8080"), "TaskMate API host URL")
+	token := flags.String("token", os.Getenv("TASKMATE_TOKEN"), "API token (defaults to $TASKMATE_TOKEN)")
+	out := flags.String("out", ".", "directory to write the generated configuration to")
+
+	var filters filterFlag
+	flags.Var(&filters, "filter", "only export tasks matching key=value (status, priority, title, label, project_id or assignee); may be repeated")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	client := provider.NewClient(*host, *token)
+
+	tasks, err := client.ListTasks(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list tasks: %w", err)
+	}
+
+	var selected []*provider.Task
+	for _, task := range tasks {
+		if filters.filter.Match(task) {
+			selected = append(selected, task)
+		}
+	}
+
+	resources, imports := render(selected)
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(*out, resourcesFile), resources, 0o644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(*out, importsFile), imports, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Exported %d tasks to %s\n", len(selected), *out)
+	return nil
+}
+
+// render returns the resource and import blocks for tasks.
+func render(tasks []*provider.Task) ([]byte, []byte) {
+	var resources, imports bytes.Buffer
+	names := make(map[string]bool)
+
+	for i, task := range tasks {
+		name := uniqueName(names, resourceName(task.Title), task.ID)
+		names[name] = true
+
+		if i > 0 {
+			resources.WriteString("\n")
+			imports.WriteString("\n")
+		}
+
+		fmt.Fprintf(&resources, "resource \"taskmate_task\" %q {\n", name)
+		writeAttributes(&resources, [][2]string{
+			{"title", stringValue(task.Title)},
+			{"description", stringValue(task.Description)},
+			{"due_date", stringValue(task.DueDate)},
+			{"priority", stringValue(task.Priority)},
+			{"status", stringValue(task.Status)},
+			{"external_id", stringValue(task.ExternalID)},
+			{"labels", listValue(task.Labels)},
+			{"project_id", numberValue(task.ProjectID)},
+			{"assignee", stringValue(task.Assignee)},
+			{"watchers", listValue(task.Watchers)},
+			{"recurrence", stringValue(task.Recurrence)},
+			{"workflow_id", numberValue(task.WorkflowID)},
+			{"estimate_minutes", numberValue(task.EstimateMinutes)},
+		})
+		resources.WriteString("}\n")
+
+		fmt.Fprintf(&imports, "import {\n  to = taskmate_task.%s\n  id = %s\n}\n", name, hclString(strconv.Itoa(task.ID)))
+	}
+
+	return resources.Bytes(), imports.Bytes()
+}
+
+// writeAttributes writes name/expression attributes, skipping empty
+// expressions and aligning the equals signs the way terraform fmt does.
+func writeAttributes(buf *bytes.Buffer, attrs [][2]string) {
+	width := 0
+	for _, attr := range attrs {
+		if attr[1] != "" && len(attr[0]) > width {
+			width = len(attr[0])
+		}
+	}
+
+	for _, attr := range attrs {
+		if attr[1] == "" {
+			continue
+		}
+		fmt.Fprintf(buf, "  %-*s = %s\n", width, attr[0], attr[1])
+	}
+}
+
+// stringValue returns s as an HCL string literal, or "" if s is empty.
+func stringValue(s string) string {
+	if s == "" {
+		return ""
+	}
+	return hclString(s)
+}
+
+// numberValue returns n as an HCL number, or "" if n is zero.
+func numberValue(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// listValue returns values as a sorted HCL list of strings, or "" if there
+// are none.
+func listValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = hclString(v)
+	}
+	slices.Sort(quoted)
+
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// uniqueName returns name, or name suffixed with the task ID and then a
+// counter, whichever is first not yet in names.
+func uniqueName(names map[string]bool, name string, id int) string {
+	if !names[name] {
+		return name
+	}
+
+	base := fmt.Sprintf("%s_%d", name, id)
+	name = base
+	for n := 2; names[name]; n++ {
+		name = fmt.Sprintf("%s_%d", base, n)
+	}
+	return name
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// resourceName turns a task title into a valid Terraform resource name.
+func resourceName(title string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(title), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "task_" + name
+	}
+	return strings.TrimRight(name, "_")
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences.
+func hclString(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			// Double the marker so ${ and %{ are not read as templates.
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
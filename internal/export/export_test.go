@@ -0,0 +1,138 @@
+package export
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-taskmate/internal/provider"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks []*provider.Task
+		want  []string
+	}{
+		{
+			name: "distinct titles",
+			tasks: []*provider.Task{
+				{ID: 1, Title: "Deploy"},
+				{ID: 2, Title: "Review"},
+			},
+			want: []string{"deploy", "review"},
+		},
+		{
+			name: "duplicate titles",
+			tasks: []*provider.Task{
+				{ID: 1, Title: "Deploy"},
+				{ID: 2, Title: "deploy!"},
+			},
+			want: []string{"deploy", "deploy_2"},
+		},
+		{
+			name: "suffix collides with a title",
+			tasks: []*provider.Task{
+				{ID: 1, Title: "deploy_7"},
+				{ID: 2, Title: "Deploy"},
+				{ID: 7, Title: "Deploy"},
+			},
+			want: []string{"deploy_7", "deploy", "deploy_7_2"},
+		},
+	}
+
+	resourceBlock := regexp.MustCompile(`(?m)^resource "taskmate_task" "([^"]+)" \{$`)
+	importTarget := regexp.MustCompile(`(?m)^  to = taskmate_task\.(\S+)$`)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources, imports := render(tt.tasks)
+
+			var got []string
+			for _, m := range resourceBlock.FindAllStringSubmatch(string(resources), -1) {
+				got = append(got, m[1])
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("resource names = %v, want %v", got, tt.want)
+			}
+
+			got = nil
+			for _, m := range importTarget.FindAllStringSubmatch(string(imports), -1) {
+				got = append(got, m[1])
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("import targets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderAttributes(t *testing.T) {
+	resources, imports := render([]*provider.Task{{
+		ID:        42,
+		Title:     "Deploy",
+		Priority:  "high",
+		Labels:    []string{"ops", "backend"},
+		ProjectID: 3,
+	}})
+
+	want := `resource "taskmate_task" "deploy" {
+  title      = "Deploy"
+  priority   = "high"
+  labels     = ["backend", "ops"]
+  project_id = 3
+}
+`
+	if string(resources) != want {
+		t.Errorf("resources =\n%s\nwant\n%s", resources, want)
+	}
+
+	wantImports := "import {\n  to = taskmate_task.deploy\n  id = \"42\"\n}\n"
+	if string(imports) != wantImports {
+		t.Errorf("imports =\n%s\nwant\n%s", imports, wantImports)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Deploy", "deploy"},
+		{"Deploy to Production", "deploy_to_production"},
+		{"  Fix: login (v2) ", "fix_login_v2"},
+		{"2024 review", "task_2024_review"},
+		{"!!!", "task"},
+		{"", "task"},
+		{"Ünïcode café", "n_code_caf"},
+	}
+
+	for _, tt := range tests {
+		if got := resourceName(tt.title); got != tt.want {
+			t.Errorf("resourceName(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestHCLString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain", `"plain"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`C:\path`, `"C:\\path"`},
+		{"line\nbreak\ttab\r", `"line\nbreak\ttab\r"`},
+		{"bell\a", `"bell\u0007"`},
+		{"${var.x}", `"$${var.x}"`},
+		{"%{ if x }", `"%%{ if x }"`},
+		{"cost $5 or 50%", `"cost $5 or 50%"`},
+		{"ends with $", `"ends with $"`},
+	}
+
+	for _, tt := range tests {
+		if got := hclString(tt.in); got != tt.want {
+			t.Errorf("hclString(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APITokenResource{}
+var _ resource.ResourceWithModifyPlan = &APITokenResource{}
+
+func NewAPITokenResource() resource.Resource {
+	return &APITokenResource{}
+}
+
+// APITokenResource defines the resource implementation.
+type APITokenResource struct {
+	client *Client
+}
+
+// APITokenResourceModel describes the resource data model.
+type APITokenResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Scopes         types.List   `tfsdk:"scopes"`
+	TTL            types.String `tfsdk:"ttl"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	RotationWindow types.String `tfsdk:"rotation_window"`
+	Token          types.String `tfsdk:"token"`
+}
+
+func (r *APITokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *APITokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `TaskMate API token resource
+
+Provisions a long-lived API token, for example one per team. The token value is
+stored in state as a sensitive attribute and revoked on destroy.
+
+Set ` + "`ttl`" + ` and ` + "`rotation_window`" + ` to rotate tokens automatically: once the
+token expires within the window, the next plan replaces it.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "taskmate_api_token" "platform" {
+  name            = "platform-team"
+  scopes          = ["tasks:read", "tasks:write"]
+  ttl             = "720h"
+  rotation_window = "168h"
+}
+` + "```" + `
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Token identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Token name. Changing this forces a new token",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes granted to the token. Changing this forces a new token",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Token lifetime as a duration, such as `720h`. Conflicts with a configured `expires_at`. Changing this forces a new token",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry timestamp (RFC 3339). Computed from `ttl` or the server default when not set. Changing this forces a new token",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_window": schema.StringAttribute{
+				MarkdownDescription: "Plan a replacement once the token expires within this duration, such as `168h`",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The API token",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APITokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan replaces the token once it expires within rotation_window.
+func (r *APITokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config APITokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TTL.IsNull() && !config.ExpiresAt.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ttl"),
+			"Conflicting Token Expiry",
+			"Only one of ttl and expires_at can be set.",
+		)
+		return
+	}
+
+	// Nothing to rotate on create, or without a window.
+	if req.State.Raw.IsNull() || plan.RotationWindow.IsNull() || plan.RotationWindow.IsUnknown() {
+		return
+	}
+
+	if plan.ExpiresAt.IsNull() || plan.ExpiresAt.IsUnknown() {
+		return
+	}
+
+	window, err := time.ParseDuration(plan.RotationWindow.ValueString())
+	if err != nil {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid Expiry", fmt.Sprintf("Unable to parse expires_at: %s", err))
+		return
+	}
+
+	if time.Until(expiresAt) > window {
+		return
+	}
+
+	if !config.ExpiresAt.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Token Due for Rotation",
+			fmt.Sprintf("The token expires at %s, within the rotation window, but expires_at is set explicitly. "+
+				"Move expires_at forward (or use ttl) to rotate it.", plan.ExpiresAt.ValueString()),
+		)
+		return
+	}
+
+	plan.ID = types.StringUnknown()
+	plan.ExpiresAt = types.StringUnknown()
+	plan.Token = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("token"))
+}
+
+func (r *APITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APITokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenReq := TokenRequest{
+		Name: data.Name.ValueString(),
+	}
+
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &tokenReq.Scopes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TTL.IsNull() {
+		ttl, _ := time.ParseDuration(data.TTL.ValueString())
+		tokenReq.TTL = int64(ttl / time.Second)
+	}
+	if !data.ExpiresAt.IsUnknown() && !data.ExpiresAt.IsNull() {
+		tokenReq.ExpiresAt = data.ExpiresAt.ValueString()
+	}
+
+	token, err := r.client.CreateToken(ctx, tokenReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create token, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(token.ID)
+	data.Token = types.StringValue(token.Token)
+	if data.ExpiresAt.IsUnknown() {
+		data.ExpiresAt = types.StringNull()
+		if token.ExpiresAt != nil {
+			data.ExpiresAt = types.StringValue(token.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APITokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API has no endpoint to look tokens up, so the only drift we can
+	// detect is expiry. Expired tokens are dropped so they get recreated.
+	if !data.ExpiresAt.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err == nil && !time.Now().Before(expiresAt) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data APITokenResourceModel
+
+	// Everything but rotation_window forces replacement, so there is nothing
+	// to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APITokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RevokeToken(ctx, &APIToken{
+		ID:    data.ID.ValueString(),
+		Token: data.Token.ValueString(),
+	})
+	if errors.Is(err, ErrRevocationUnsupported) {
+		resp.Diagnostics.AddWarning(
+			"Token Not Revoked",
+			fmt.Sprintf("The TaskMate server does not support token revocation, so token %q stays valid until it expires.", data.Name.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke token, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checklistItemType is the object type of a checklist item in state.
+var checklistItemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":   types.Int64Type,
+		"text": types.StringType,
+		"done": types.BoolType,
+	},
+}
+
+// checklistValue converts checklist items into a known list value.
+func checklistValue(items []ChecklistItem) types.List {
+	elements := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		elements = append(elements, types.ObjectValueMust(checklistItemType.AttrTypes, map[string]attr.Value{
+			"id":   types.Int64Value(int64(item.ID)),
+			"text": types.StringValue(item.Text),
+			"done": types.BoolValue(item.Done),
+		}))
+	}
+
+	return types.ListValueMust(checklistItemType, elements)
+}
+
+// checklistItems returns the items of a known checklist. Items whose ID is
+// not known yet get ID 0, which the API treats as a new item. Null and
+// unknown checklists yield nil.
+func checklistItems(list types.List) []ChecklistItem {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	items := make([]ChecklistItem, 0, len(list.Elements()))
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := object.Attributes()
+
+		var item ChecklistItem
+		if id, ok := attrs["id"].(types.Int64); ok && !id.IsUnknown() {
+			item.ID = int(id.ValueInt64())
+		}
+		if text, ok := attrs["text"].(types.String); ok {
+			item.Text = text.ValueString()
+		}
+		if done, ok := attrs["done"].(types.Bool); ok {
+			item.Done = done.ValueBool()
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// checklistProgress returns the percentage of done checklist items, rounded
+// down. An empty checklist is 0% done. The result is unknown while any done
+// flag is.
+func checklistProgress(list types.List) types.Int64 {
+	if list.IsUnknown() {
+		return types.Int64Unknown()
+	}
+
+	total, done := 0, 0
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			return types.Int64Unknown()
+		}
+
+		flag, ok := object.Attributes()["done"].(types.Bool)
+		if !ok || flag.IsUnknown() {
+			return types.Int64Unknown()
+		}
+
+		total++
+		if flag.ValueBool() {
+			done++
+		}
+	}
+
+	if total == 0 {
+		return types.Int64Value(0)
+	}
+
+	return types.Int64Value(int64(done * 100 / total))
+}
+
+// planChecklist returns the planned checklist for the configured one. Each
+// configured item is matched to a prior item, first by text and then by
+// position, and keeps that item's ID, so the API updates items in place
+// instead of recreating the list. Items that leave done unset keep the prior
+// value, so ticking them in TaskMate does not cause a diff. A checklist left
+// out of the configuration is not managed and keeps its prior value.
+func planChecklist(config, prior types.List) types.List {
+	if config.IsUnknown() {
+		return types.ListUnknown(checklistItemType)
+	}
+
+	if config.IsNull() {
+		if prior.IsNull() {
+			return types.ListUnknown(checklistItemType)
+		}
+		return prior
+	}
+
+	var priorItems []map[string]attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		for _, element := range prior.Elements() {
+			if object, ok := element.(types.Object); ok {
+				priorItems = append(priorItems, object.Attributes())
+			}
+		}
+	}
+
+	configItems := make([]map[string]attr.Value, 0, len(config.Elements()))
+	for _, element := range config.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			return types.ListUnknown(checklistItemType)
+		}
+		configItems = append(configItems, object.Attributes())
+	}
+
+	match := make([]int, len(configItems))
+	used := make([]bool, len(priorItems))
+
+	for i, item := range configItems {
+		match[i] = -1
+		text := item["text"]
+		if text.IsUnknown() {
+			continue
+		}
+		for j, priorItem := range priorItems {
+			if !used[j] && priorItem["text"].Equal(text) {
+				match[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	for i := range configItems {
+		if match[i] == -1 && i < len(priorItems) && !used[i] {
+			match[i] = i
+			used[i] = true
+		}
+	}
+
+	elements := make([]attr.Value, 0, len(configItems))
+	for i, item := range configItems {
+		id := attr.Value(types.Int64Unknown())
+		done := item["done"]
+
+		if j := match[i]; j >= 0 {
+			id = priorItems[j]["id"]
+			if done.IsNull() {
+				done = priorItems[j]["done"]
+			}
+		}
+		if done.IsNull() {
+			done = types.BoolValue(false)
+		}
+
+		elements = append(elements, types.ObjectValueMust(checklistItemType.AttrTypes, map[string]attr.Value{
+			"id":   id,
+			"text": item["text"],
+			"done": done,
+		}))
+	}
+
+	return types.ListValueMust(checklistItemType, elements)
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checklistItem builds a checklist item object. A negative id is unknown and
+// a nil done is null.
+func checklistItem(id int64, text string, done *bool) attr.Value {
+	idValue := types.Int64Value(id)
+	if id < 0 {
+		idValue = types.Int64Unknown()
+	}
+	doneValue := types.BoolNull()
+	if done != nil {
+		doneValue = types.BoolValue(*done)
+	}
+
+	return types.ObjectValueMust(checklistItemType.AttrTypes, map[string]attr.Value{
+		"id":   idValue,
+		"text": types.StringValue(text),
+		"done": doneValue,
+	})
+}
+
+func checklist(items ...attr.Value) types.List {
+	return types.ListValueMust(checklistItemType, items)
+}
+
+func TestPlanChecklist(t *testing.T) {
+	yes, no := true, false
+
+	prior := checklist(
+		checklistItem(1, "Write", &yes),
+		checklistItem(2, "Review", &no),
+		checklistItem(3, "Ship", &no),
+	)
+
+	tests := []struct {
+		name   string
+		config types.List
+		prior  types.List
+		want   types.List
+	}{
+		{
+			name:   "unchanged keeps IDs and done",
+			config: checklist(checklistItem(0, "Write", nil), checklistItem(0, "Review", nil), checklistItem(0, "Ship", nil)),
+			prior:  prior,
+			want:   prior,
+		},
+		{
+			name:   "reordered items are matched by text",
+			config: checklist(checklistItem(0, "Ship", nil), checklistItem(0, "Write", nil)),
+			prior:  prior,
+			want:   checklist(checklistItem(3, "Ship", &no), checklistItem(1, "Write", &yes)),
+		},
+		{
+			name:   "renamed item keeps the ID at its position",
+			config: checklist(checklistItem(0, "Write", nil), checklistItem(0, "Peer review", nil), checklistItem(0, "Ship", nil)),
+			prior:  prior,
+			want:   checklist(checklistItem(1, "Write", &yes), checklistItem(2, "Peer review", &no), checklistItem(3, "Ship", &no)),
+		},
+		{
+			name:   "new item gets an unknown ID",
+			config: checklist(checklistItem(0, "Write", nil), checklistItem(0, "Review", nil), checklistItem(0, "Ship", nil), checklistItem(0, "Announce", nil)),
+			prior:  prior,
+			want:   checklist(checklistItem(1, "Write", &yes), checklistItem(2, "Review", &no), checklistItem(3, "Ship", &no), checklistItem(-1, "Announce", &no)),
+		},
+		{
+			name:   "configured done wins",
+			config: checklist(checklistItem(0, "Write", &no)),
+			prior:  prior,
+			want:   checklist(checklistItem(1, "Write", &no)),
+		},
+		{
+			name:   "duplicate texts match distinct items",
+			config: checklist(checklistItem(0, "Check", nil), checklistItem(0, "Check", nil)),
+			prior:  checklist(checklistItem(7, "Check", &yes), checklistItem(8, "Check", &no)),
+			want:   checklist(checklistItem(7, "Check", &yes), checklistItem(8, "Check", &no)),
+		},
+		{
+			name:   "no prior checklist",
+			config: checklist(checklistItem(0, "Write", nil)),
+			prior:  types.ListNull(checklistItemType),
+			want:   checklist(checklistItem(-1, "Write", &no)),
+		},
+		{
+			name:   "unset checklist keeps the prior one",
+			config: types.ListNull(checklistItemType),
+			prior:  prior,
+			want:   prior,
+		},
+		{
+			name:   "unset checklist on a new task is computed",
+			config: types.ListNull(checklistItemType),
+			prior:  types.ListNull(checklistItemType),
+			want:   types.ListUnknown(checklistItemType),
+		},
+		{
+			name:   "unknown checklist",
+			config: types.ListUnknown(checklistItemType),
+			prior:  prior,
+			want:   types.ListUnknown(checklistItemType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := planChecklist(tt.config, tt.prior); !got.Equal(tt.want) {
+				t.Errorf("planChecklist =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecklistProgress(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name string
+		list types.List
+		want types.Int64
+	}{
+		{"empty", checklist(), types.Int64Value(0)},
+		{"none done", checklist(checklistItem(1, "a", &no)), types.Int64Value(0)},
+		{"rounded down", checklist(checklistItem(1, "a", &yes), checklistItem(2, "b", &no), checklistItem(3, "c", &no)), types.Int64Value(33)},
+		{"all done", checklist(checklistItem(1, "a", &yes)), types.Int64Value(100)},
+		{"unknown", types.ListUnknown(checklistItemType), types.Int64Unknown()},
+	}
+
+	for _, tt := range tests {
+		if got := checklistProgress(tt.list); !got.Equal(tt.want) {
+			t.Errorf("%s: checklistProgress = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
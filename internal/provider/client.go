@@ -2,47 +2,304 @@ package provider
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
+	"net"
 	"net/http"
+	"net/textproto"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 )
 
 // Client handles API communication with TaskMate
 type Client struct {
-	Host   string
-	Token  string
-	client *http.Client
+	Host  string
+	Token string
+	// DefaultLabels are merged into the labels of every managed task.
+	DefaultLabels []string
+	client        *http.Client
 }
 
 // Task represents a task from the API
 type Task struct {
+	ID               int             `json:"id"`
+	Title            string          `json:"title"`
+	Description      string          `json:"description"`
+	DueDate          string          `json:"due_date"`
+	Priority         string          `json:"priority"`
+	Status           string          `json:"status"`
+	ExternalID       string          `json:"external_id,omitempty"`
+	Labels           []string        `json:"labels,omitempty"`
+	ProjectID        int             `json:"project_id,omitempty"`
+	Checklist        []ChecklistItem `json:"checklist,omitempty"`
+	Assignee         string          `json:"assignee,omitempty"`
+	Watchers         []string        `json:"watchers,omitempty"`
+	Recurrence       string          `json:"recurrence,omitempty"`
+	WorkflowID       int             `json:"workflow_id,omitempty"`
+	EstimateMinutes  int             `json:"estimate_minutes,omitempty"`
+	TimeSpentMinutes int             `json:"time_spent_minutes,omitempty"`
+	CompletedAt      *time.Time      `json:"completed_at,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+
+	// ETag is the entity tag the server returned with the task, if any.
+	ETag string `json:"-"`
+}
+
+// TaskInput holds the fields sent when creating a task
+type TaskInput struct {
+	Title           string          `json:"title"`
+	Description     string          `json:"description"`
+	DueDate         string          `json:"due_date"`
+	Priority        string          `json:"priority"`
+	ExternalID      string          `json:"external_id,omitempty"`
+	Labels          []string        `json:"labels,omitempty"`
+	ProjectID       int             `json:"project_id,omitempty"`
+	Checklist       []ChecklistItem `json:"checklist,omitempty"`
+	Assignee        string          `json:"assignee,omitempty"`
+	Watchers        []string        `json:"watchers,omitempty"`
+	Recurrence      string          `json:"recurrence,omitempty"`
+	WorkflowID      int             `json:"workflow_id,omitempty"`
+	EstimateMinutes int             `json:"estimate_minutes,omitempty"`
+}
+
+// ChecklistItem is one entry of a task's ordered checklist. Items sent
+// without an ID are created; items with an ID are updated in place.
+type ChecklistItem struct {
+	ID   int    `json:"id,omitempty"`
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// User represents a TaskMate user. Tasks refer to users by username.
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+}
+
+// Project represents a project from the API
+type Project struct {
 	ID          int       `json:"id"`
-	Title       string    `json:"title"`
+	Name        string    `json:"name"`
 	Description string    `json:"description"`
-	DueDate     string    `json:"due_date"`
-	Priority    string    `json:"priority"`
-	Status      string    `json:"status"`
+	Owner       string    `json:"owner"`
+	Archived    bool      `json:"archived"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// ProjectInput holds the fields sent when creating or updating a project.
+// An empty Owner leaves the choice to the server.
+type ProjectInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Owner       string `json:"owner,omitempty"`
+	Archived    bool   `json:"archived"`
+}
+
+// Workflow is a set of task statuses and the transitions allowed between
+// them. Statuses without a transition are final.
+type Workflow struct {
+	ID            int                  `json:"id"`
+	Name          string               `json:"name"`
+	Statuses      []string             `json:"statuses"`
+	InitialStatus string               `json:"initial_status"`
+	Transitions   []WorkflowTransition `json:"transitions"`
+	CreatedAt     time.Time            `json:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at"`
+}
+
+// WorkflowTransition lists the statuses a task may move to from From.
+type WorkflowTransition struct {
+	From string   `json:"from"`
+	To   []string `json:"to"`
+}
+
+// WorkflowInput holds the fields sent when creating or updating a workflow.
+// An empty InitialStatus leaves the choice to the server.
+type WorkflowInput struct {
+	Name          string               `json:"name"`
+	Statuses      []string             `json:"statuses"`
+	InitialStatus string               `json:"initial_status,omitempty"`
+	Transitions   []WorkflowTransition `json:"transitions"`
+}
+
+// Dependency represents a dependency between two tasks. With type "blocks",
+// TaskID cannot be completed before DependsOnTaskID.
+type Dependency struct {
+	ID              int       `json:"id"`
+	TaskID          int       `json:"task_id"`
+	DependsOnTaskID int       `json:"depends_on_task_id"`
+	Type            string    `json:"type"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Comment represents a comment on a task
+type Comment struct {
+	ID        int       `json:"id"`
+	TaskID    int       `json:"task_id"`
+	Body      string    `json:"body"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// commentPage is one page of a comment listing. NextPage is 0 on the last
+// page.
+type commentPage struct {
+	Comments []*Comment `json:"comments"`
+	NextPage int        `json:"next_page"`
+}
+
+// commentsPerPage is the page size requested when listing comments.
+const commentsPerPage = 100
+
+// Attachment represents a file attached to a task
+type Attachment struct {
+	ID          int       `json:"id"`
+	TaskID      int       `json:"task_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TokenRequest describes an API token to issue. All fields are optional.
+type TokenRequest struct {
+	Name      string   `json:"name,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+	TTL       int64    `json:"ttl_seconds,omitempty"`
+	ExpiresAt string   `json:"expires_at,omitempty"`
+}
+
+// APIToken represents an API token issued by the auth endpoint
+type APIToken struct {
+	ID        string     `json:"id,omitempty"`
+	Token     string     `json:"token"`
+	Name      string     `json:"name,omitempty"`
+	Scopes    []string   `json:"scopes,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ErrRevocationUnsupported is returned when the server has no endpoint for
+// revoking tokens.
+var ErrRevocationUnsupported = errors.New("token revocation is not supported by the server")
+
+// TaskPatch holds the fields to change in a partial update. Nil fields are
+// left untouched on the server.
+type TaskPatch struct {
+	Title       *string   `json:"title,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	DueDate     *string   `json:"due_date,omitempty"`
+	Priority    *string   `json:"priority,omitempty"`
+	Status      *string   `json:"status,omitempty"`
+	Labels      *[]string `json:"labels,omitempty"`
+	// ProjectID moves the task to another project; 0 removes it from its
+	// project.
+	ProjectID *int `json:"project_id,omitempty"`
+	// Checklist replaces the checklist, in order. Items missing from it are
+	// removed.
+	Checklist *[]ChecklistItem `json:"checklist,omitempty"`
+	// Assignee reassigns the task; "" unassigns it.
+	Assignee *string   `json:"assignee,omitempty"`
+	Watchers *[]string `json:"watchers,omitempty"`
+	// Recurrence sets the task's RRULE; "" stops it recurring.
+	Recurrence *string `json:"recurrence,omitempty"`
+	// WorkflowID moves the task to another workflow; 0 returns it to the
+	// default statuses.
+	WorkflowID *int `json:"workflow_id,omitempty"`
+	// EstimateMinutes sets the estimated effort; 0 clears it.
+	EstimateMinutes *int `json:"estimate_minutes,omitempty"`
+}
+
+// TaskFilter narrows a task listing. Empty fields match every task.
+type TaskFilter struct {
+	Status   string
+	Priority string
+	// Title matches tasks whose title contains it, ignoring case.
+	Title string
+	// Labels matches tasks carrying every one of them.
+	Labels []string
+	// ProjectID matches tasks in the given project.
+	ProjectID int
+	// Assignee matches tasks assigned to the given username.
+	Assignee string
+}
+
+// Match reports whether task satisfies the filter.
+func (f TaskFilter) Match(task *Task) bool {
+	if f.Status != "" && task.Status != f.Status {
+		return false
+	}
+	if f.Priority != "" && task.Priority != f.Priority {
+		return false
+	}
+	if f.Title != "" && !strings.Contains(strings.ToLower(task.Title), strings.ToLower(f.Title)) {
+		return false
+	}
+	if f.ProjectID != 0 && task.ProjectID != f.ProjectID {
+		return false
+	}
+	if f.Assignee != "" && task.Assignee != f.Assignee {
+		return false
+	}
+	for _, label := range f.Labels {
+		if !slices.Contains(task.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+// ErrTaskExists is returned when the server refuses to create a task because
+// it conflicts with an existing one.
+var ErrTaskExists = errors.New("task already exists")
+
+// ErrPreconditionFailed is returned when the server rejects a conditional
+// write because the task changed since it was last read.
+var ErrPreconditionFailed = errors.New("task was modified since it was last read")
+
 // NewClient creates a new TaskMate API client
 func NewClient(host, token string) *Client {
 	return &Client{
 		Host:  host,
 		Token: token,
-		client: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		// No client-wide timeout: each call is bounded by its context, so
+		// resource timeouts can give a slow server more time.
+		client: &http.Client{},
 	}
 }
 
-// makeRequest is a helper to make HTTP requests
-func (c *Client) makeRequest(method, path string, body interface{}) (*http.Response, error) {
-	url := c.Host + "/api/v1" + path
+// makeRequest is a helper to make HTTP requests. The request is bound to ctx,
+// so any deadline on it covers the whole call.
+func (c *Client) makeRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	return c.makeRequestWithHeaders(ctx, method, path, nil, body)
+}
+
+// makeConditionalRequest is like makeRequest but sends ifMatch, when set, as
+// the If-Match header.
+func (c *Client) makeConditionalRequest(ctx context.Context, method, path, ifMatch string, body interface{}) (*http.Response, error) {
+	var headers map[string]string
+	if ifMatch != "" {
+		headers = map[string]string{"If-Match": ifMatch}
+	}
+
+	return c.makeRequestWithHeaders(ctx, method, path, headers, body)
+}
 
+// makeRequestWithHeaders is like makeRequest but adds the given headers.
+func (c *Client) makeRequestWithHeaders(ctx context.Context, method, path string, headers map[string]string, body interface{}) (*http.Response, error) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -52,7 +309,22 @@ func (c *Client) makeRequest(method, path string, body interface{}) (*http.Respo
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := c.newRequest(ctx, method, path, "application/json", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	return c.client.Do(req)
+}
+
+// newRequest builds an authenticated API request with the given body.
+func (c *Client) newRequest(ctx context.Context, method, path, contentType string, body io.Reader) (*http.Request, error) {
+	reqURL := c.Host + "/api/v1" + path
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -61,26 +333,48 @@ func (c *Client) makeRequest(method, path string, body interface{}) (*http.Respo
 	if c.Token != "" {
 		req.Header.Set("X-API-Token", c.Token)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
-	return c.client.Do(req)
+	return req, nil
 }
 
-// CreateTask creates a new task
-func (c *Client) CreateTask(title, description, dueDate, priority string) (*Task, error) {
-	reqBody := map[string]string{
-		"title":       title,
-		"description": description,
-		"due_date":    dueDate,
-		"priority":    priority,
-	}
+// createAttempts is how many times CreateTask sends a create whose response
+// was lost to a timeout.
+const createAttempts = 3
 
-	resp, err := c.makeRequest("POST", "/tasks", reqBody)
+// defaultCreateAttemptTimeout bounds one create attempt when the caller's
+// context has no deadline.
+const defaultCreateAttemptTimeout = 30 * time.Second
+
+// CreateTask creates a new task. The request carries an Idempotency-Key, and
+// a create that times out is resent with the same key, so the server returns
+// the task it already made instead of a duplicate.
+func (c *Client) CreateTask(ctx context.Context, reqBody TaskInput) (*Task, error) {
+	key, err := idempotencyKey(reqBody)
 	if err != nil {
 		return nil, err
 	}
+
+	var resp *http.Response
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, createAttemptTimeout(ctx, createAttempts-attempt+1))
+		resp, err = c.makeRequestWithHeaders(attemptCtx, "POST", "/tasks", map[string]string{"Idempotency-Key": key}, reqBody)
+		if err == nil {
+			// The body is read under the same deadline.
+			defer cancel()
+			break
+		}
+		cancel()
+		if attempt == createAttempts || ctx.Err() != nil || !isTimeout(err) {
+			return nil, err
+		}
+	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusConflict {
+		return nil, fmt.Errorf("task %q: %w", reqBody.Title, ErrTaskExists)
+	}
+
 	if resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
@@ -90,13 +384,46 @@ func (c *Client) CreateTask(title, description, dueDate, priority string) (*Task
 	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	task.ETag = resp.Header.Get("ETag")
 
 	return &task, nil
 }
 
+// createAttemptTimeout returns the deadline for one create attempt: an even
+// share of the time left on ctx between the attempts still to come, so a slow
+// server gets most of the create timeout while a lost response leaves room to
+// retry.
+func createAttemptTimeout(ctx context.Context, attemptsLeft int) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultCreateAttemptTimeout
+	}
+	return time.Until(deadline) / time.Duration(attemptsLeft)
+}
+
+// idempotencyKey returns the Idempotency-Key for a create request body. It is
+// derived from the body alone, so re-running an apply after a lost response
+// finds the task already made instead of creating a duplicate.
+func idempotencyKey(body TaskInput) (string, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	sum := sha256.Sum256(jsonBody)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// isTimeout reports whether err is a request that timed out before a
+// response arrived.
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 // GetTask retrieves a task by ID
-func (c *Client) GetTask(id int) (*Task, error) {
-	resp, err := c.makeRequest("GET", fmt.Sprintf("/tasks/%d", id), nil)
+func (c *Client) GetTask(ctx context.Context, id int) (*Task, error) {
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/tasks/%d", id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -115,21 +442,15 @@ func (c *Client) GetTask(id int) (*Task, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	task.ETag = resp.Header.Get("ETag")
 
 	return &task, nil
 }
 
-// UpdateTask updates an existing task
-func (c *Client) UpdateTask(id int, title, description, dueDate, priority, status string) (*Task, error) {
-	reqBody := map[string]string{
-		"title":       title,
-		"description": description,
-		"due_date":    dueDate,
-		"priority":    priority,
-		"status":      status,
-	}
-
-	resp, err := c.makeRequest("PUT", fmt.Sprintf("/tasks/%d", id), reqBody)
+// PatchTask updates only the fields set in patch. When ifMatch is set the
+// update only succeeds if the task's current ETag still matches it.
+func (c *Client) PatchTask(ctx context.Context, id int, ifMatch string, patch TaskPatch) (*Task, error) {
+	resp, err := c.makeConditionalRequest(ctx, "PATCH", fmt.Sprintf("/tasks/%d", id), ifMatch, patch)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +460,10 @@ func (c *Client) UpdateTask(id int, title, description, dueDate, priority, statu
 		return nil, fmt.Errorf("task with ID %d not found", id)
 	}
 
+	if resp.StatusCode == http.StatusPreconditionFailed || resp.StatusCode == http.StatusConflict {
+		return nil, fmt.Errorf("task with ID %d: %w", id, ErrPreconditionFailed)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
@@ -148,13 +473,15 @@ func (c *Client) UpdateTask(id int, title, description, dueDate, priority, statu
 	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	task.ETag = resp.Header.Get("ETag")
 
 	return &task, nil
 }
 
-// DeleteTask deletes a task by ID
-func (c *Client) DeleteTask(id int) error {
-	resp, err := c.makeRequest("DELETE", fmt.Sprintf("/tasks/%d", id), nil)
+// DeleteTask deletes a task by ID. When ifMatch is set the delete only
+// succeeds if the task's current ETag still matches it.
+func (c *Client) DeleteTask(ctx context.Context, id int, ifMatch string) error {
+	resp, err := c.makeConditionalRequest(ctx, "DELETE", fmt.Sprintf("/tasks/%d", id), ifMatch, nil)
 	if err != nil {
 		return err
 	}
@@ -164,6 +491,10 @@ func (c *Client) DeleteTask(id int) error {
 		return fmt.Errorf("task with ID %d not found", id)
 	}
 
+	if resp.StatusCode == http.StatusPreconditionFailed || resp.StatusCode == http.StatusConflict {
+		return fmt.Errorf("task with ID %d: %w", id, ErrPreconditionFailed)
+	}
+
 	if resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
@@ -173,8 +504,8 @@ func (c *Client) DeleteTask(id int) error {
 }
 
 // ListTasks retrieves all tasks
-func (c *Client) ListTasks() ([]*Task, error) {
-	resp, err := c.makeRequest("GET", "/tasks", nil)
+func (c *Client) ListTasks(ctx context.Context) ([]*Task, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/tasks", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -192,3 +523,632 @@ func (c *Client) ListTasks() ([]*Task, error) {
 
 	return tasks, nil
 }
+
+// CreateToken issues a new API token
+func (c *Client) CreateToken(ctx context.Context, reqBody TokenRequest) (*APIToken, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/auth/token", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var token APIToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if token.Token == "" {
+		return nil, errors.New("API response did not include a token")
+	}
+
+	return &token, nil
+}
+
+// RevokeToken revokes an API token. Tokens with an ID are revoked by ID;
+// otherwise the token authenticates its own revocation. It returns
+// ErrRevocationUnsupported if the server has no revocation endpoint.
+func (c *Client) RevokeToken(ctx context.Context, token *APIToken) error {
+	var resp *http.Response
+	var err error
+
+	if token.ID != "" {
+		resp, err = c.makeRequest(ctx, "DELETE", "/auth/token/"+url.PathEscape(token.ID), nil)
+	} else {
+		resp, err = c.makeRequestWithHeaders(ctx, "DELETE", "/auth/token", map[string]string{"X-API-Token": token.Token}, nil)
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		// Already revoked or expired.
+		return nil
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return ErrRevocationUnsupported
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+}
+
+// ListUsers retrieves all users
+func (c *Client) ListUsers(ctx context.Context) ([]*User, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/users", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var users []*User
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return users, nil
+}
+
+// CreateProject creates a new project
+func (c *Client) CreateProject(ctx context.Context, reqBody ProjectInput) (*Project, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/projects", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var project Project
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &project, nil
+}
+
+// GetProject retrieves a project by ID
+func (c *Client) GetProject(ctx context.Context, id int) (*Project, error) {
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/projects/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("project with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var project Project
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &project, nil
+}
+
+// UpdateProject replaces a project's fields
+func (c *Client) UpdateProject(ctx context.Context, id int, reqBody ProjectInput) (*Project, error) {
+	resp, err := c.makeRequest(ctx, "PUT", fmt.Sprintf("/projects/%d", id), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("project with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var project Project
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &project, nil
+}
+
+// DeleteProject deletes a project by ID
+func (c *Client) DeleteProject(ctx context.Context, id int) error {
+	resp, err := c.makeRequest(ctx, "DELETE", fmt.Sprintf("/projects/%d", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("project with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	return nil
+}
+
+// ListProjects retrieves all projects
+func (c *Client) ListProjects(ctx context.Context) ([]*Project, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/projects", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var projects []*Project
+	if err := json.NewDecoder(resp.Body).Decode(&projects); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return projects, nil
+}
+
+// CreateWorkflow creates a new workflow
+func (c *Client) CreateWorkflow(ctx context.Context, reqBody WorkflowInput) (*Workflow, error) {
+	resp, err := c.makeRequest(ctx, "POST", "/workflows", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var workflow Workflow
+	if err := json.NewDecoder(resp.Body).Decode(&workflow); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &workflow, nil
+}
+
+// GetWorkflow retrieves a workflow by ID
+func (c *Client) GetWorkflow(ctx context.Context, id int) (*Workflow, error) {
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/workflows/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("workflow with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var workflow Workflow
+	if err := json.NewDecoder(resp.Body).Decode(&workflow); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &workflow, nil
+}
+
+// UpdateWorkflow replaces a workflow's fields
+func (c *Client) UpdateWorkflow(ctx context.Context, id int, reqBody WorkflowInput) (*Workflow, error) {
+	resp, err := c.makeRequest(ctx, "PUT", fmt.Sprintf("/workflows/%d", id), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("workflow with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var workflow Workflow
+	if err := json.NewDecoder(resp.Body).Decode(&workflow); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &workflow, nil
+}
+
+// DeleteWorkflow deletes a workflow by ID
+func (c *Client) DeleteWorkflow(ctx context.Context, id int) error {
+	resp, err := c.makeRequest(ctx, "DELETE", fmt.Sprintf("/workflows/%d", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("workflow with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	return nil
+}
+
+// CreateDependency records that one task depends on another
+func (c *Client) CreateDependency(ctx context.Context, taskID, dependsOnTaskID int, depType string) (*Dependency, error) {
+	reqBody := Dependency{
+		TaskID:          taskID,
+		DependsOnTaskID: dependsOnTaskID,
+		Type:            depType,
+	}
+
+	resp, err := c.makeRequest(ctx, "POST", "/dependencies", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var dep Dependency
+	if err := json.NewDecoder(resp.Body).Decode(&dep); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &dep, nil
+}
+
+// GetDependency retrieves a dependency by ID
+func (c *Client) GetDependency(ctx context.Context, id int) (*Dependency, error) {
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/dependencies/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("dependency with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var dep Dependency
+	if err := json.NewDecoder(resp.Body).Decode(&dep); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &dep, nil
+}
+
+// DeleteDependency deletes a dependency by ID
+func (c *Client) DeleteDependency(ctx context.Context, id int) error {
+	resp, err := c.makeRequest(ctx, "DELETE", fmt.Sprintf("/dependencies/%d", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("dependency with ID %d not found", id)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	return nil
+}
+
+// ListDependencies retrieves all task dependencies. Servers without
+// dependency support answer 404, which is treated as having none.
+func (c *Client) ListDependencies(ctx context.Context) ([]*Dependency, error) {
+	resp, err := c.makeRequest(ctx, "GET", "/dependencies", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var deps []*Dependency
+	if err := json.NewDecoder(resp.Body).Decode(&deps); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return deps, nil
+}
+
+// CreateComment adds a comment to a task
+func (c *Client) CreateComment(ctx context.Context, taskID int, body string) (*Comment, error) {
+	resp, err := c.makeRequest(ctx, "POST", fmt.Sprintf("/tasks/%d/comments", taskID), map[string]string{"body": body})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task with ID %d not found", taskID)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var comment Comment
+	if err := json.NewDecoder(resp.Body).Decode(&comment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// GetComment retrieves a comment on a task by ID
+func (c *Client) GetComment(ctx context.Context, taskID, id int) (*Comment, error) {
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/tasks/%d/comments/%d", taskID, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("comment with ID %d on task %d not found", id, taskID)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var comment Comment
+	if err := json.NewDecoder(resp.Body).Decode(&comment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// UpdateComment changes the body of a comment
+func (c *Client) UpdateComment(ctx context.Context, taskID, id int, body string) (*Comment, error) {
+	resp, err := c.makeRequest(ctx, "PATCH", fmt.Sprintf("/tasks/%d/comments/%d", taskID, id), map[string]string{"body": body})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("comment with ID %d on task %d not found", id, taskID)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var comment Comment
+	if err := json.NewDecoder(resp.Body).Decode(&comment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// DeleteComment deletes a comment on a task
+func (c *Client) DeleteComment(ctx context.Context, taskID, id int) error {
+	resp, err := c.makeRequest(ctx, "DELETE", fmt.Sprintf("/tasks/%d/comments/%d", taskID, id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("comment with ID %d on task %d not found", id, taskID)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	return nil
+}
+
+// ListComments retrieves a task's comments, oldest first, following pages
+// until limit comments are collected. A limit of 0 returns every comment.
+func (c *Client) ListComments(ctx context.Context, taskID, limit int) ([]*Comment, error) {
+	var comments []*Comment
+
+	page := 1
+	for {
+		reqPath := fmt.Sprintf("/tasks/%d/comments?page=%d&per_page=%d", taskID, page, commentsPerPage)
+
+		resp, err := c.makeRequest(ctx, "GET", reqPath, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := decodeCommentPage(resp, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, result.Comments...)
+		if limit > 0 && len(comments) >= limit {
+			return comments[:limit], nil
+		}
+
+		// The last page has no next page. Also stop if a server keeps
+		// pointing back at pages already read.
+		if result.NextPage <= page {
+			return comments, nil
+		}
+		page = result.NextPage
+	}
+}
+
+// decodeCommentPage reads one page of a comment listing and closes the
+// response body.
+func decodeCommentPage(resp *http.Response, taskID int) (*commentPage, error) {
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task with ID %d not found", taskID)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var page commentPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &page, nil
+}
+
+// UploadAttachment attaches a file to a task. The content is streamed as a
+// multipart/form-data upload, so large files are never held in memory. The
+// upload is bound only by ctx, not the client's per-request timeout.
+func (c *Client) UploadAttachment(ctx context.Context, taskID int, filename, contentType string, content io.Reader) (*Attachment, error) {
+	pr, pw := io.Pipe()
+	form := multipart.NewWriter(pw)
+
+	go func() {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, multipartEscaper.Replace(filename)))
+		header.Set("Content-Type", contentType)
+
+		part, err := form.CreatePart(header)
+		if err == nil {
+			_, err = io.Copy(part, content)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/tasks/%d/attachments", taskID), form.FormDataContentType(), pr)
+	if err != nil {
+		pr.Close()
+		return nil, err
+	}
+
+	uploader := &http.Client{Transport: c.client.Transport}
+	resp, err := uploader.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("task with ID %d not found", taskID)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var attachment Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+// multipartEscaper escapes quotes in multipart header parameters the way
+// mime/multipart does.
+var multipartEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// GetAttachment retrieves an attachment's metadata
+func (c *Client) GetAttachment(ctx context.Context, taskID, id int) (*Attachment, error) {
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/tasks/%d/attachments/%d", taskID, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("attachment with ID %d on task %d not found", id, taskID)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	var attachment Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+// DeleteAttachment removes an attachment from a task
+func (c *Client) DeleteAttachment(ctx context.Context, taskID, id int) error {
+	resp, err := c.makeRequest(ctx, "DELETE", fmt.Sprintf("/tasks/%d/attachments/%d", taskID, id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("attachment with ID %d on task %d not found", id, taskID)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s (status: %d)", string(body), resp.StatusCode)
+	}
+
+	return nil
+}
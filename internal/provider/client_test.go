@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestListComments(t *testing.T) {
+	// Three pages of two comments each; page 3 is the last one.
+	pages := map[int]commentPage{
+		1: {Comments: []*Comment{{ID: 1}, {ID: 2}}, NextPage: 2},
+		2: {Comments: []*Comment{{ID: 3}, {ID: 4}}, NextPage: 3},
+		3: {Comments: []*Comment{{ID: 5}, {ID: 6}}},
+	}
+
+	tests := []struct {
+		name      string
+		pages     map[int]commentPage
+		limit     int
+		wantIDs   []int
+		wantPages int
+	}{
+		{name: "all pages", pages: pages, wantIDs: []int{1, 2, 3, 4, 5, 6}, wantPages: 3},
+		{name: "limit within the first page", pages: pages, limit: 1, wantIDs: []int{1}, wantPages: 1},
+		{name: "limit on a page boundary", pages: pages, limit: 4, wantIDs: []int{1, 2, 3, 4}, wantPages: 2},
+		{name: "limit above the total", pages: pages, limit: 10, wantIDs: []int{1, 2, 3, 4, 5, 6}, wantPages: 3},
+		{
+			name: "server pointing back at a read page",
+			pages: map[int]commentPage{
+				1: {Comments: []*Comment{{ID: 1}}, NextPage: 2},
+				2: {Comments: []*Comment{{ID: 2}}, NextPage: 1},
+			},
+			wantIDs:   []int{1, 2},
+			wantPages: 2,
+		},
+		{name: "no comments", pages: map[int]commentPage{1: {}}, wantIDs: nil, wantPages: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.URL.Path != "/api/v1/tasks/7/comments" {
+					http.NotFound(w, r)
+					return
+				}
+				if got := r.URL.Query().Get("per_page"); got != strconv.Itoa(commentsPerPage) {
+					t.Errorf("per_page = %q, want %d", got, commentsPerPage)
+				}
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				_ = json.NewEncoder(w).Encode(tt.pages[page])
+			}))
+			defer server.Close()
+
+			comments, err := NewClient(server.URL, "token").ListComments(context.Background(), 7, tt.limit)
+			if err != nil {
+				t.Fatalf("ListComments returned error: %s", err)
+			}
+
+			var ids []int
+			for _, comment := range comments {
+				ids = append(ids, comment.ID)
+			}
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("comment IDs = %v, want %v", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Fatalf("comment IDs = %v, want %v", ids, tt.wantIDs)
+				}
+			}
+			if requests != tt.wantPages {
+				t.Errorf("fetched %d pages, want %d", requests, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestListCommentsTaskNotFound(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "token").ListComments(context.Background(), 7, 0)
+	if err == nil || err.Error() != "task with ID 7 not found" {
+		t.Errorf("ListComments error = %v, want task with ID 7 not found", err)
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	key := func(body TaskInput) string {
+		t.Helper()
+
+		k, err := idempotencyKey(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+
+	plain := TaskInput{Title: "Deploy", Priority: "high"}
+	if key(plain) != key(plain) {
+		t.Error("the same task got different keys")
+	}
+
+	if key(plain) == key(TaskInput{Title: "Deploy", Priority: "low"}) {
+		t.Error("tasks with different fields got the same key")
+	}
+
+	withID := TaskInput{Title: "Deploy", Priority: "high", ExternalID: "deploy-1"}
+	otherID := TaskInput{Title: "Deploy", Priority: "high", ExternalID: "deploy-2"}
+	if key(withID) == key(otherID) {
+		t.Error("tasks with different external_ids got the same key")
+	}
+}
+
+func TestCreateTaskRetriesTimeouts(t *testing.T) {
+	var mu sync.Mutex
+	var keys []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		attempt := len(keys)
+		mu.Unlock()
+
+		// Lose the first response to the attempt's timeout.
+		if attempt == 1 {
+			time.Sleep(250 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(Task{ID: 5, Title: "Deploy"})
+	}))
+	defer server.Close()
+
+	// The first attempt gets a third of the deadline: 100ms.
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	task, err := NewClient(server.URL, "token").CreateTask(ctx, TaskInput{Title: "Deploy"})
+	if err != nil {
+		t.Fatalf("CreateTask returned error: %s", err)
+	}
+	if task.ID != 5 {
+		t.Errorf("task ID = %d, want 5", task.ID)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(keys) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("Idempotency-Key changed between attempts: %q, %q", keys[0], keys[1])
+	}
+}
+
+func TestCreateTaskDoesNotRetryErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "token").CreateTask(context.Background(), TaskInput{Title: "Deploy"}); err == nil {
+		t.Fatal("CreateTask succeeded, want error")
+	}
+	if requests != 1 {
+		t.Errorf("sent %d requests, want 1", requests)
+	}
+}
+
+func TestCreateAttemptTimeout(t *testing.T) {
+	if got := createAttemptTimeout(context.Background(), createAttempts); got != defaultCreateAttemptTimeout {
+		t.Errorf("without a deadline = %s, want %s", got, defaultCreateAttemptTimeout)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	tests := []struct {
+		attemptsLeft int
+		min, max     time.Duration
+	}{
+		{attemptsLeft: 3, min: 3*time.Minute + 19*time.Second, max: 3*time.Minute + 20*time.Second},
+		{attemptsLeft: 1, min: 9*time.Minute + 59*time.Second, max: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := createAttemptTimeout(ctx, tt.attemptsLeft); got < tt.min || got > tt.max {
+			t.Errorf("with %d attempts left = %s, want between %s and %s", tt.attemptsLeft, got, tt.min, tt.max)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// dueDateLayout is the canonical format for task due dates.
+const dueDateLayout = "2006-01-02"
+
+// dateLayouts are the formats normalizeDate accepts, tried in order.
+var dateLayouts = []string{
+	dueDateLayout,
+	time.RFC3339,
+	"2006/01/02",
+	"20060102",
+	"2006-01-02 15:04:05",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+}
+
+// taskPriorityRanks orders task priorities from lowest to highest.
+var taskPriorityRanks = map[string]int64{
+	"low":    1,
+	"medium": 2,
+	"high":   3,
+}
+
+// parseDueDate parses a due date in the canonical YYYY-MM-DD format,
+// rejecting impossible dates such as 2024-02-31.
+func parseDueDate(s string) (time.Time, error) {
+	t, err := time.Parse(dueDateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a valid date in YYYY-MM-DD format", s)
+	}
+
+	return t, nil
+}
+
+// normalizeDate parses s in any of dateLayouts and returns it as YYYY-MM-DD.
+func normalizeDate(s string) (string, error) {
+	s = strings.TrimSpace(s)
+
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format(dueDateLayout), nil
+		}
+	}
+
+	return "", fmt.Errorf("%q is not a recognized date; use YYYY-MM-DD or an RFC 3339 timestamp", s)
+}
+
+// parseDate is like normalizeDate but returns the date at midnight UTC.
+func parseDate(s string) (time.Time, error) {
+	normalized, err := normalizeDate(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return parseDueDate(normalized)
+}
+
+var _ validator.String = dueDateValidator{}
+
+// dueDateValidator checks that a string is a date in YYYY-MM-DD format.
+type dueDateValidator struct{}
+
+func (v dueDateValidator) Description(ctx context.Context) string {
+	return "value must be a date in YYYY-MM-DD format"
+}
+
+func (v dueDateValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a date in `YYYY-MM-DD` format"
+}
+
+func (v dueDateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseDueDate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Due Date",
+			fmt.Sprintf("%s. Use provider::taskmate::normalize_date to convert other formats.", err),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator checks that a string is a positive Go duration such as
+// "15m" or "72h".
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 15m or 72h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration such as `15m` or `72h`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%q is not a positive duration such as \"15m\" or \"72h\".", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import "testing"
+
+func TestNormalizeDate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "2025-03-01", want: "2025-03-01"},
+		{input: "2025-03-01T23:30:00-05:00", want: "2025-03-01"},
+		{input: "2025-03-01T00:00:00Z", want: "2025-03-01"},
+		{input: "2025/03/01", want: "2025-03-01"},
+		{input: "20250301", want: "2025-03-01"},
+		{input: "2025-03-01 14:00:00", want: "2025-03-01"},
+		{input: "Mar 1, 2025", want: "2025-03-01"},
+		{input: "March 1, 2025", want: "2025-03-01"},
+		{input: "1 Mar 2025", want: "2025-03-01"},
+		{input: "1 March 2025", want: "2025-03-01"},
+		{input: "  2025-03-01\n", want: "2025-03-01"},
+		{input: "2024-02-29", want: "2024-02-29"},
+		{input: "2025-02-29", wantErr: true},
+		{input: "2025-02-30", wantErr: true},
+		{input: "2025/13/01", wantErr: true},
+		{input: "01/03/2025", wantErr: true},
+		{input: "tomorrow", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeDate(tt.input)
+		switch {
+		case tt.wantErr && err == nil:
+			t.Errorf("normalizeDate(%q) = %q, want error", tt.input, got)
+		case !tt.wantErr && err != nil:
+			t.Errorf("normalizeDate(%q) returned error: %s", tt.input, err)
+		case got != tt.want:
+			t.Errorf("normalizeDate(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr bool
+	}{
+		{input: "2025-03-01"},
+		{input: "2024-02-29"},
+		{input: "2025-02-30", wantErr: true},
+		{input: "2024-02-31", wantErr: true},
+		{input: "2025-3-1", wantErr: true},
+		{input: "2025/03/01", wantErr: true},
+		{input: "2025-03-01T00:00:00Z", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDueDate(tt.input)
+		switch {
+		case tt.wantErr && err == nil:
+			t.Errorf("parseDueDate(%q) = %s, want error", tt.input, got)
+		case !tt.wantErr && err != nil:
+			t.Errorf("parseDueDate(%q) returned error: %s", tt.input, err)
+		case !tt.wantErr && got.Format(dueDateLayout) != tt.input:
+			t.Errorf("parseDueDate(%q) = %s", tt.input, got)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Dependency types. Only blocking dependencies order tasks, so only they can
+// form cycles.
+const (
+	dependencyTypeBlocks  = "blocks"
+	dependencyTypeRelates = "relates"
+)
+
+// dependencyCycle reports whether making taskID depend on dependsOnTaskID
+// would close a cycle of blocking dependencies. If so it returns the cycle,
+// starting and ending at taskID. The dependency with ID ignoreID, if any, is
+// left out, so a dependency being replaced does not conflict with itself.
+func dependencyCycle(deps []*Dependency, taskID, dependsOnTaskID, ignoreID int) []int {
+	if taskID == dependsOnTaskID {
+		return []int{taskID, taskID}
+	}
+
+	edges := make(map[int][]int)
+	for _, dep := range deps {
+		if dep.ID == ignoreID || dep.Type != dependencyTypeBlocks {
+			continue
+		}
+		edges[dep.TaskID] = append(edges[dep.TaskID], dep.DependsOnTaskID)
+	}
+
+	// Breadth-first search from the new dependency back to taskID.
+	parent := map[int]int{dependsOnTaskID: taskID}
+	queue := []int{dependsOnTaskID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, next := range edges[current] {
+			if _, seen := parent[next]; seen {
+				continue
+			}
+			parent[next] = current
+
+			if next == taskID {
+				cycle := []int{taskID}
+				for node := current; node != taskID; node = parent[node] {
+					cycle = append(cycle, node)
+				}
+				cycle = append(cycle, taskID)
+				slices.Reverse(cycle)
+				return cycle
+			}
+
+			queue = append(queue, next)
+		}
+	}
+
+	return nil
+}
+
+// formatCycle renders a dependency cycle as "1 → 2 → 1".
+func formatCycle(cycle []int) string {
+	ids := make([]string, len(cycle))
+	for i, id := range cycle {
+		ids[i] = strconv.Itoa(id)
+	}
+	return strings.Join(ids, " → ")
+}
+
+// taskBlockers returns the sorted IDs of the tasks blocking id and the tasks
+// id blocks.
+func taskBlockers(deps []*Dependency, id int) (blockedBy, blocking []int64) {
+	blockedBy, blocking = []int64{}, []int64{}
+	for _, dep := range deps {
+		if dep.Type != dependencyTypeBlocks {
+			continue
+		}
+		if dep.TaskID == id {
+			blockedBy = append(blockedBy, int64(dep.DependsOnTaskID))
+		}
+		if dep.DependsOnTaskID == id {
+			blocking = append(blocking, int64(dep.TaskID))
+		}
+	}
+	slices.Sort(blockedBy)
+	slices.Sort(blocking)
+
+	return blockedBy, blocking
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDependencyCycle(t *testing.T) {
+	// 1 depends on 2, 2 on 3, 3 on 4; 5 relates to 1.
+	deps := []*Dependency{
+		{ID: 10, TaskID: 1, DependsOnTaskID: 2, Type: dependencyTypeBlocks},
+		{ID: 11, TaskID: 2, DependsOnTaskID: 3, Type: dependencyTypeBlocks},
+		{ID: 12, TaskID: 3, DependsOnTaskID: 4, Type: dependencyTypeBlocks},
+		{ID: 13, TaskID: 5, DependsOnTaskID: 1, Type: dependencyTypeRelates},
+	}
+
+	tests := []struct {
+		name            string
+		taskID          int
+		dependsOnTaskID int
+		ignoreID        int
+		want            []int
+	}{
+		{
+			name:            "self",
+			taskID:          7,
+			dependsOnTaskID: 7,
+			want:            []int{7, 7},
+		},
+		{
+			name:            "direct",
+			taskID:          2,
+			dependsOnTaskID: 1,
+			want:            []int{2, 1, 2},
+		},
+		{
+			name:            "transitive",
+			taskID:          4,
+			dependsOnTaskID: 1,
+			want:            []int{4, 1, 2, 3, 4},
+		},
+		{
+			name:            "same direction as existing chain",
+			taskID:          1,
+			dependsOnTaskID: 4,
+		},
+		{
+			name:            "unrelated tasks",
+			taskID:          6,
+			dependsOnTaskID: 1,
+		},
+		{
+			name:            "relates dependencies do not block",
+			taskID:          1,
+			dependsOnTaskID: 5,
+		},
+		{
+			name:            "replaced dependency is ignored",
+			taskID:          3,
+			dependsOnTaskID: 2,
+			ignoreID:        11,
+		},
+		{
+			name:            "cycle through another path is still found when replacing",
+			taskID:          4,
+			dependsOnTaskID: 2,
+			ignoreID:        10,
+			want:            []int{4, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dependencyCycle(deps, tt.taskID, tt.dependsOnTaskID, tt.ignoreID)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("dependencyCycle = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyCycleShortest(t *testing.T) {
+	// 2 reaches 1 both directly through 3 and through the longer 4 → 5.
+	deps := []*Dependency{
+		{ID: 1, TaskID: 2, DependsOnTaskID: 4, Type: dependencyTypeBlocks},
+		{ID: 2, TaskID: 4, DependsOnTaskID: 5, Type: dependencyTypeBlocks},
+		{ID: 3, TaskID: 5, DependsOnTaskID: 1, Type: dependencyTypeBlocks},
+		{ID: 4, TaskID: 2, DependsOnTaskID: 3, Type: dependencyTypeBlocks},
+		{ID: 5, TaskID: 3, DependsOnTaskID: 1, Type: dependencyTypeBlocks},
+	}
+
+	want := []int{1, 2, 3, 1}
+	if got := dependencyCycle(deps, 1, 2, 0); !slices.Equal(got, want) {
+		t.Errorf("dependencyCycle = %v, want %v", got, want)
+	}
+	if got := formatCycle(want); got != "1 → 2 → 3 → 1" {
+		t.Errorf("formatCycle = %q", got)
+	}
+}
+
+func TestTaskBlockers(t *testing.T) {
+	deps := []*Dependency{
+		{TaskID: 1, DependsOnTaskID: 3, Type: dependencyTypeBlocks},
+		{TaskID: 1, DependsOnTaskID: 2, Type: dependencyTypeBlocks},
+		{TaskID: 4, DependsOnTaskID: 1, Type: dependencyTypeBlocks},
+		{TaskID: 1, DependsOnTaskID: 5, Type: dependencyTypeRelates},
+	}
+
+	blockedBy, blocking := taskBlockers(deps, 1)
+	if !slices.Equal(blockedBy, []int64{2, 3}) {
+		t.Errorf("blockedBy = %v, want [2 3]", blockedBy)
+	}
+	if !slices.Equal(blocking, []int64{4}) {
+		t.Errorf("blocking = %v, want [4]", blocking)
+	}
+
+	blockedBy, blocking = taskBlockers(nil, 1)
+	if blockedBy == nil || blocking == nil || len(blockedBy)+len(blocking) != 0 {
+		t.Errorf("taskBlockers(nil) = %v, %v, want empty non-nil slices", blockedBy, blocking)
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &DueInFunction{}
+
+func NewDueInFunction() function.Function {
+	return &DueInFunction{}
+}
+
+// DueInFunction defines the due_in function implementation.
+type DueInFunction struct{}
+
+func (f *DueInFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "due_in"
+}
+
+func (f *DueInFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Date a number of days from another date",
+		MarkdownDescription: "Returns the date `days` days after `from` in `YYYY-MM-DD` format, ready for `due_date`. `from` may be a date or an RFC 3339 timestamp such as the result of `timestamp()`. Negative `days` count backwards.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "days",
+				MarkdownDescription: "Number of days to add",
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "Starting date",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DueInFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var days int64
+	var from string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &days, &from))
+
+	if resp.Error != nil {
+		return
+	}
+
+	start, err := parseDate(from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, start.AddDate(0, 0, int(days)).Format(dueDateLayout)))
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDueInFunction(t *testing.T) {
+	tests := []struct {
+		days    int64
+		from    string
+		want    string
+		wantErr bool
+	}{
+		{days: 0, from: "2025-03-01", want: "2025-03-01"},
+		{days: 7, from: "2025-03-01", want: "2025-03-08"},
+		{days: 1, from: "2024-02-28", want: "2024-02-29"},
+		{days: 1, from: "2025-12-31", want: "2026-01-01"},
+		{days: -1, from: "2025-03-01", want: "2025-02-28"},
+		{days: -30, from: "2025-03-01", want: "2025-01-30"},
+		{days: 7, from: "March 1, 2025", want: "2025-03-08"},
+		{days: 7, from: "2025-02-30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.Int64Value(tt.days), types.StringValue(tt.from)}),
+		}
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.StringUnknown()),
+		}
+
+		NewDueInFunction().Run(context.Background(), req, &resp)
+
+		if tt.wantErr {
+			if resp.Error == nil {
+				t.Errorf("due_in(%d, %q) succeeded, want error", tt.days, tt.from)
+			}
+			continue
+		}
+		if resp.Error != nil {
+			t.Errorf("due_in(%d, %q) returned error: %s", tt.days, tt.from, resp.Error)
+			continue
+		}
+		if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+			t.Errorf("due_in(%d, %q) = %q, want %q", tt.days, tt.from, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IsOverdueFunction{}
+
+func NewIsOverdueFunction() function.Function {
+	return &IsOverdueFunction{}
+}
+
+// IsOverdueFunction defines the is_overdue function implementation.
+type IsOverdueFunction struct{}
+
+func (f *IsOverdueFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_overdue"
+}
+
+func (f *IsOverdueFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Whether a due date has passed",
+		MarkdownDescription: "Returns true if `due_date` is strictly before `as_of`. An empty `due_date` is never overdue. `as_of` may be a date or an RFC 3339 timestamp such as the result of `timestamp()`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "due_date",
+				MarkdownDescription: "Task due date in `YYYY-MM-DD` format",
+			},
+			function.StringParameter{
+				Name:                "as_of",
+				MarkdownDescription: "Date to compare against",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsOverdueFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var dueDate, asOf string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &dueDate, &asOf))
+
+	if resp.Error != nil {
+		return
+	}
+
+	reference, err := parseDate(asOf)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	if dueDate == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, false))
+		return
+	}
+
+	due, err := parseDueDate(dueDate)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, due.Before(reference)))
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsOverdueFunction(t *testing.T) {
+	tests := []struct {
+		dueDate string
+		asOf    string
+		want    bool
+		wantErr bool
+	}{
+		{dueDate: "2025-02-28", asOf: "2025-03-01", want: true},
+		{dueDate: "2025-03-01", asOf: "2025-03-01", want: false},
+		{dueDate: "2025-03-02", asOf: "2025-03-01", want: false},
+		{dueDate: "2025-02-28", asOf: "2025-03-01T08:00:00Z", want: true},
+		{dueDate: "", asOf: "2025-03-01", want: false},
+		{dueDate: "2025-02-30", asOf: "2025-03-01", wantErr: true},
+		{dueDate: "2025-02-28", asOf: "2025-02-30", wantErr: true},
+		{dueDate: "", asOf: "someday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.dueDate), types.StringValue(tt.asOf)}),
+		}
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.BoolUnknown()),
+		}
+
+		NewIsOverdueFunction().Run(context.Background(), req, &resp)
+
+		if tt.wantErr {
+			if resp.Error == nil {
+				t.Errorf("is_overdue(%q, %q) succeeded, want error", tt.dueDate, tt.asOf)
+			}
+			continue
+		}
+		if resp.Error != nil {
+			t.Errorf("is_overdue(%q, %q) returned error: %s", tt.dueDate, tt.asOf, resp.Error)
+			continue
+		}
+		if got := resp.Result.Value().(types.Bool).ValueBool(); got != tt.want {
+			t.Errorf("is_overdue(%q, %q) = %t, want %t", tt.dueDate, tt.asOf, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// labelSet converts labels into a known set value. A nil slice becomes an
+// empty set, so computed label attributes are never null.
+func labelSet(labels []string) types.Set {
+	elements := make([]attr.Value, 0, len(labels))
+	for _, label := range labels {
+		elements = append(elements, types.StringValue(label))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// setLabels returns the sorted labels held in a set value. Null and unknown
+// sets yield an empty, non-nil slice.
+func setLabels(set types.Set) []string {
+	labels := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return labels
+	}
+
+	for _, element := range set.Elements() {
+		if s, ok := element.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			labels = append(labels, s.ValueString())
+		}
+	}
+	slices.Sort(labels)
+
+	return labels
+}
+
+// mergeLabels returns the sorted union of the given label lists.
+func mergeLabels(lists ...[]string) []string {
+	merged := []string{}
+	for _, list := range lists {
+		merged = append(merged, list...)
+	}
+	slices.Sort(merged)
+
+	return slices.Compact(merged)
+}
+
+// keepLabels returns the labels of want that are also in have.
+func keepLabels(want, have []string) []string {
+	kept := []string{}
+	for _, label := range want {
+		if slices.Contains(have, label) {
+			kept = append(kept, label)
+		}
+	}
+
+	return kept
+}
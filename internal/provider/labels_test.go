@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeLabels(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists [][]string
+		want  []string
+	}{
+		{"none", nil, []string{}},
+		{"empty lists", [][]string{nil, {}}, []string{}},
+		{"sorted", [][]string{{"ops", "backend"}}, []string{"backend", "ops"}},
+		{"union", [][]string{{"team:web", "urgent"}, {"managed-by:terraform", "team:web"}}, []string{"managed-by:terraform", "team:web", "urgent"}},
+		{"duplicates within a list", [][]string{{"a", "a", "b"}}, []string{"a", "b"}},
+		{"case sensitive", [][]string{{"Ops"}, {"ops"}}, []string{"Ops", "ops"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeLabels(tt.lists...)
+			if got == nil || !slices.Equal(got, tt.want) {
+				t.Errorf("mergeLabels = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeepLabels(t *testing.T) {
+	got := keepLabels([]string{"a", "b", "c"}, []string{"c", "a", "z"})
+	if !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("keepLabels = %v, want [a c]", got)
+	}
+}
+
+func TestSetLabels(t *testing.T) {
+	if got := setLabels(types.SetNull(types.StringType)); got == nil || len(got) != 0 {
+		t.Errorf("setLabels(null) = %#v, want empty slice", got)
+	}
+	if got := setLabels(types.SetUnknown(types.StringType)); got == nil || len(got) != 0 {
+		t.Errorf("setLabels(unknown) = %#v, want empty slice", got)
+	}
+	if got := setLabels(labelSet([]string{"b", "a"})); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("setLabels = %v, want [a b]", got)
+	}
+	if got := labelSet(nil); got.IsNull() || len(got.Elements()) != 0 {
+		t.Errorf("labelSet(nil) = %s, want empty set", got)
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeDateFunction{}
+
+func NewNormalizeDateFunction() function.Function {
+	return &NormalizeDateFunction{}
+}
+
+// NormalizeDateFunction defines the normalize_date function implementation.
+type NormalizeDateFunction struct{}
+
+func (f *NormalizeDateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_date"
+}
+
+func (f *NormalizeDateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert a date to YYYY-MM-DD",
+		MarkdownDescription: "Parses a date such as `2024/12/31`, `20241231`, `Dec 31, 2024` or an RFC 3339 timestamp and returns it in the `YYYY-MM-DD` format `due_date` expects.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "date",
+				MarkdownDescription: "Date to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeDateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var date string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &date))
+
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeDate(date)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
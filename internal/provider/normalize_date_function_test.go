@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeDateFunction(t *testing.T) {
+	run := func(date string) function.RunResponse {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(date)}),
+		}
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.StringUnknown()),
+		}
+
+		NewNormalizeDateFunction().Run(context.Background(), req, &resp)
+		return resp
+	}
+
+	resp := run("1 March 2025")
+	if resp.Error != nil {
+		t.Fatalf("normalize_date returned error: %s", resp.Error)
+	}
+	if got := resp.Result.Value().(types.String).ValueString(); got != "2025-03-01" {
+		t.Errorf("normalize_date = %q, want 2025-03-01", got)
+	}
+
+	resp = run("2025-02-30")
+	if resp.Error == nil || !strings.Contains(resp.Error.Error(), "not a recognized date") {
+		t.Errorf("normalize_date(2025-02-30) error = %v, want not a recognized date", resp.Error)
+	}
+}
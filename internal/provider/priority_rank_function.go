@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PriorityRankFunction{}
+
+func NewPriorityRankFunction() function.Function {
+	return &PriorityRankFunction{}
+}
+
+// PriorityRankFunction defines the priority_rank function implementation.
+type PriorityRankFunction struct{}
+
+func (f *PriorityRankFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "priority_rank"
+}
+
+func (f *PriorityRankFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Sortable rank of a task priority",
+		MarkdownDescription: "Returns 1 for `low`, 2 for `medium` and 3 for `high`, so tasks can be sorted by priority.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "priority",
+				MarkdownDescription: "Task priority",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *PriorityRankFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var priority string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &priority))
+
+	if resp.Error != nil {
+		return
+	}
+
+	rank, ok := taskPriorityRanks[priority]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("unknown priority %q; expected low, medium or high", priority))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, rank))
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPriorityRankFunction(t *testing.T) {
+	tests := []struct {
+		priority string
+		want     int64
+		wantErr  bool
+	}{
+		{priority: "low", want: 1},
+		{priority: "medium", want: 2},
+		{priority: "high", want: 3},
+		{priority: "High", wantErr: true},
+		{priority: "urgent", wantErr: true},
+		{priority: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.priority)}),
+		}
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.Int64Unknown()),
+		}
+
+		NewPriorityRankFunction().Run(context.Background(), req, &resp)
+
+		if tt.wantErr {
+			if resp.Error == nil {
+				t.Errorf("priority_rank(%q) succeeded, want error", tt.priority)
+			}
+			continue
+		}
+		if resp.Error != nil {
+			t.Errorf("priority_rank(%q) returned error: %s", tt.priority, resp.Error)
+			continue
+		}
+		if got := resp.Result.Value().(types.Int64).ValueInt64(); got != tt.want {
+			t.Errorf("priority_rank(%q) = %d, want %d", tt.priority, got, tt.want)
+		}
+	}
+}
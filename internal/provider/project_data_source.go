@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource defines the data source implementation.
+type ProjectDataSource struct {
+	client *Client
+}
+
+// ProjectDataSourceModel describes the data source data model.
+type ProjectDataSourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Owner       types.String `tfsdk:"owner"`
+	Archived    types.Bool   `tfsdk:"archived"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "TaskMate project data source - looks up a project by ID or exact name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Project identifier. Exactly one of `id` and `name` must be set",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Project name. Exactly one of `id` and `name` must be set",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Project description",
+				Computed:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Username of the project owner",
+				Computed:            true,
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is archived",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProjectDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var project *Project
+	var err error
+
+	if !data.ID.IsNull() {
+		project, err = d.client.GetProject(ctx, int(data.ID.ValueInt64()))
+	} else {
+		project, err = d.findProject(ctx, data.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	data.ID = types.Int64Value(int64(project.ID))
+	data.Name = types.StringValue(project.Name)
+	data.Description = types.StringValue(project.Description)
+	data.Owner = types.StringValue(project.Owner)
+	data.Archived = types.BoolValue(project.Archived)
+	data.CreatedAt = types.StringValue(project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findProject returns the single project named name.
+func (d *ProjectDataSource) findProject(ctx context.Context, name string) (*Project, error) {
+	projects, err := d.client.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*Project
+	for _, project := range projects {
+		if project.Name == name {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no project named %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d projects are named %q; look the project up by id instead", len(matches), name)
+	}
+}
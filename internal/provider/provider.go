@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +15,9 @@ import (
 
 // Ensure TaskMateProvider satisfies various provider interfaces.
 var _ provider.Provider = &TaskMateProvider{}
+var _ provider.ProviderWithListResources = &TaskMateProvider{}
+var _ provider.ProviderWithFunctions = &TaskMateProvider{}
+var _ provider.ProviderWithEphemeralResources = &TaskMateProvider{}
 
 // TaskMateProvider defines the provider implementation.
 type TaskMateProvider struct {
@@ -22,8 +28,14 @@ type TaskMateProvider struct {
 
 // TaskMateProviderModel describes the provider data model.
 type TaskMateProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host          types.String        `tfsdk:"host"`
+	Token         types.String        `tfsdk:"token"`
+	DefaultLabels *DefaultLabelsModel `tfsdk:"default_labels"`
+}
+
+// DefaultLabelsModel describes the provider's default_labels block.
+type DefaultLabelsModel struct {
+	Labels types.Set `tfsdk:"labels"`
 }
 
 func (p *TaskMateProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,6 +57,18 @@ func (p *TaskMateProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Sensitive:           true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"default_labels": schema.SingleNestedBlock{
+				MarkdownDescription: "Labels added to every `taskmate_task` managed by this provider. They are reported in the task's `effective_labels` and never cause a diff on `labels`",
+				Attributes: map[string]schema.Attribute{
+					"labels": schema.SetAttribute{
+						MarkdownDescription: "Labels to add to every task",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -67,15 +91,26 @@ func (p *TaskMateProvider) Configure(ctx context.Context, req provider.Configure
 
 	// Create API client
 	client := NewClient(host, token)
+	if data.DefaultLabels != nil {
+		client.DefaultLabels = setLabels(data.DefaultLabels.Labels)
+	}
 
 	// Make client available to resources and data sources
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *TaskMateProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewTaskResource,
+		NewAPITokenResource,
+		NewProjectResource,
+		NewTaskDependencyResource,
+		NewTaskCommentResource,
+		NewTaskAttachmentResource,
+		NewWorkflowResource,
 	}
 }
 
@@ -83,6 +118,33 @@ func (p *TaskMateProvider) DataSources(ctx context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		NewTaskDataSource,
 		NewTasksDataSource,
+		NewProjectDataSource,
+		NewTaskCommentsDataSource,
+		NewUserDataSource,
+	}
+}
+
+func (p *TaskMateProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTokenEphemeralResource,
+	}
+}
+
+func (p *TaskMateProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewTaskListResource,
+	}
+}
+
+func (p *TaskMateProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDueInFunction,
+		NewIsOverdueFunction,
+		NewPriorityRankFunction,
+		NewNormalizeDateFunction,
+		NewTasksToMarkdownFunction,
+		NewTasksToCSVFunction,
+		NewTasksToICalFunction,
 	}
 }
 
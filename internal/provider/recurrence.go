@@ -0,0 +1,477 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Recurrence modes decide who moves a recurring task's due date forward.
+const (
+	recurrenceModeServer   = "server"
+	recurrenceModeProvider = "provider"
+)
+
+// nextOccurrenceCount is the number of due dates reported in
+// next_occurrences.
+const nextOccurrenceCount = 5
+
+// maxRecurrencePeriods bounds the search for occurrences past the date asked
+// about, so rules that can never match, such as
+// FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30, terminate.
+const maxRecurrencePeriods = 5000
+
+// recurrenceRule is a parsed RFC 5545 RRULE. Task due dates are plain dates,
+// so only the parts with day granularity or coarser are supported.
+type recurrenceRule struct {
+	freq       string
+	interval   int
+	count      int
+	until      time.Time
+	byDay      []recurrenceDay
+	byMonthDay []int
+	byMonth    []time.Month
+	weekStart  time.Weekday
+}
+
+// recurrenceDay is a BYDAY entry: a weekday, optionally with an ordinal such
+// as 2 for "second" or -1 for "last". An ordinal of 0 means every such day.
+type recurrenceDay struct {
+	ordinal int
+	weekday time.Weekday
+}
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// parseRecurrence parses an RRULE value such as
+// "FREQ=MONTHLY;BYDAY=1MO", with or without the "RRULE:" prefix.
+func parseRecurrence(s string) (*recurrenceRule, error) {
+	value := strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("rule must not be empty")
+	}
+
+	rule := &recurrenceRule{interval: 1, weekStart: time.Monday}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(key)
+		if !ok || key == "" || val == "" {
+			return nil, fmt.Errorf("%q is not a NAME=VALUE rule part", part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%s is given more than once", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.freq, err = parseRecurrenceFreq(val)
+		case "INTERVAL":
+			rule.interval, err = parseRecurrenceInt(key, val)
+		case "COUNT":
+			rule.count, err = parseRecurrenceInt(key, val)
+		case "UNTIL":
+			rule.until, err = parseRecurrenceUntil(val)
+		case "BYDAY":
+			rule.byDay, err = parseRecurrenceDays(val)
+		case "BYMONTHDAY":
+			rule.byMonthDay, err = parseRecurrenceList(key, val, 31)
+		case "BYMONTH":
+			var months []int
+			months, err = parseRecurrenceList(key, val, 12)
+			for _, month := range months {
+				if month < 0 {
+					err = fmt.Errorf("BYMONTH must be between 1 and 12, got %d", month)
+				}
+				rule.byMonth = append(rule.byMonth, time.Month(month))
+			}
+		case "WKST":
+			weekday, ok := recurrenceWeekdays[strings.ToUpper(val)]
+			if !ok {
+				err = fmt.Errorf("WKST must be a weekday such as MO, got %q", val)
+			}
+			rule.weekStart = weekday
+		case "BYSECOND", "BYMINUTE", "BYHOUR":
+			err = fmt.Errorf("%s is not supported; task due dates have no time of day", key)
+		case "BYYEARDAY", "BYWEEKNO", "BYSETPOS":
+			err = fmt.Errorf("%s is not supported", key)
+		default:
+			err = fmt.Errorf("unknown rule part %s", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if rule.count > 0 && !rule.until.IsZero() {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot both be set")
+	}
+	if rule.freq == "WEEKLY" && len(rule.byMonthDay) > 0 {
+		return nil, fmt.Errorf("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	for _, day := range rule.byDay {
+		switch {
+		case day.ordinal == 0:
+		case rule.freq != "MONTHLY" && rule.freq != "YEARLY":
+			return nil, fmt.Errorf("numbered BYDAY values such as 1MO need FREQ=MONTHLY or FREQ=YEARLY")
+		case rule.freq == "MONTHLY" && (day.ordinal < -5 || day.ordinal > 5):
+			return nil, fmt.Errorf("BYDAY ordinal %d is out of range for FREQ=MONTHLY", day.ordinal)
+		}
+	}
+
+	return rule, nil
+}
+
+func parseRecurrenceFreq(val string) (string, error) {
+	freq := strings.ToUpper(val)
+	switch freq {
+	case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+		return freq, nil
+	case "SECONDLY", "MINUTELY", "HOURLY":
+		return "", fmt.Errorf("FREQ=%s is not supported; task due dates have no time of day", freq)
+	default:
+		return "", fmt.Errorf("FREQ must be DAILY, WEEKLY, MONTHLY or YEARLY, got %q", val)
+	}
+}
+
+// parseRecurrenceInt parses a positive integer.
+func parseRecurrenceInt(key, val string) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer, got %q", key, val)
+	}
+	return n, nil
+}
+
+// parseRecurrenceList parses a comma-separated list of non-zero integers
+// between -limit and limit.
+func parseRecurrenceList(key, val string, limit int) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(val, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n < -limit || n > limit {
+			return nil, fmt.Errorf("%s values must be between 1 and %d, or -%d and -1, got %q", key, limit, limit, item)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+// parseRecurrenceUntil parses an UNTIL date or date-time. Only the date is
+// kept.
+func parseRecurrenceUntil(val string) (time.Time, error) {
+	date, _, _ := strings.Cut(val, "T")
+	until, err := time.Parse("20060102", date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("UNTIL must be a date such as 20251231 or 20251231T000000Z, got %q", val)
+	}
+	return until, nil
+}
+
+func parseRecurrenceDays(val string) ([]recurrenceDay, error) {
+	var days []recurrenceDay
+	for _, item := range strings.Split(strings.ToUpper(val), ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("BYDAY values must be weekdays such as MO or 1MO, got %q", item)
+		}
+		weekday, ok := recurrenceWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("BYDAY values must be weekdays such as MO or 1MO, got %q", item)
+		}
+
+		var ordinal int
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("BYDAY values must be weekdays such as MO or 1MO, got %q", item)
+			}
+			ordinal = n
+		}
+
+		days = append(days, recurrenceDay{ordinal: ordinal, weekday: weekday})
+	}
+	return days, nil
+}
+
+// after returns up to n occurrences of the series starting at start that fall
+// after the given date, in order. start itself is always the first
+// occurrence, as in RFC 5545.
+func (r *recurrenceRule) after(start, after time.Time, n int) []time.Time {
+	var dates []time.Time
+
+	r.each(start, after, func(date time.Time) bool {
+		if date.After(after) {
+			dates = append(dates, date)
+		}
+		return len(dates) < n
+	})
+
+	return dates
+}
+
+// contains reports whether date is an occurrence of the series starting at
+// start.
+func (r *recurrenceRule) contains(start, date time.Time) bool {
+	dates := r.after(start, date.AddDate(0, 0, -1), 1)
+	return len(dates) == 1 && dates[0].Equal(date)
+}
+
+// each calls visit with the occurrences of the series starting at start, in
+// order, until visit returns false or the series ends. Occurrences before
+// the period containing from may be skipped, unless the rule has a COUNT,
+// which can only be tracked from the start of the series.
+func (r *recurrenceRule) each(start, from time.Time, visit func(time.Time) bool) {
+	count := 1
+	if !visit(start) {
+		return
+	}
+
+	last := r.periodIndex(start, from)
+	first := last
+	if r.count > 0 {
+		first = 0
+	}
+
+	for period := first; period < last+maxRecurrencePeriods; period++ {
+		from, to := r.period(start, period)
+
+		for date := from; date.Before(to); date = date.AddDate(0, 0, 1) {
+			if !date.After(start) || !r.matches(start, date) {
+				continue
+			}
+			if !r.until.IsZero() && date.After(r.until) {
+				return
+			}
+			if r.count > 0 && count >= r.count {
+				return
+			}
+			count++
+			if !visit(date) {
+				return
+			}
+		}
+	}
+}
+
+// period returns the first day and the day after the last day of the i-th
+// period of the series.
+func (r *recurrenceRule) period(start time.Time, i int) (time.Time, time.Time) {
+	step := i * r.interval
+
+	switch r.freq {
+	case "DAILY":
+		from := start.AddDate(0, 0, step)
+		return from, from.AddDate(0, 0, 1)
+	case "WEEKLY":
+		offset := (int(start.Weekday()) - int(r.weekStart) + 7) % 7
+		from := start.AddDate(0, 0, 7*step-offset)
+		return from, from.AddDate(0, 0, 7)
+	case "MONTHLY":
+		from := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, step, 0)
+		return from, from.AddDate(0, 1, 0)
+	default:
+		from := time.Date(start.Year()+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(1, 0, 0)
+	}
+}
+
+// periodIndex returns the index of the period of the series starting at
+// start that contains date, or 0 if date is before start.
+func (r *recurrenceRule) periodIndex(start, date time.Time) int {
+	if !date.After(start) {
+		return 0
+	}
+
+	var elapsed int
+	switch r.freq {
+	case "DAILY":
+		elapsed = int(date.Sub(start).Hours() / 24)
+	case "WEEKLY":
+		offset := (int(start.Weekday()) - int(r.weekStart) + 7) % 7
+		elapsed = (int(date.Sub(start).Hours()/24) + offset) / 7
+	case "MONTHLY":
+		elapsed = (date.Year()-start.Year())*12 + int(date.Month()) - int(start.Month())
+	default:
+		elapsed = date.Year() - start.Year()
+	}
+
+	return elapsed / r.interval
+}
+
+// matches reports whether date, which lies in one of the series' periods,
+// is an occurrence.
+func (r *recurrenceRule) matches(start, date time.Time) bool {
+	if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, date.Month()) {
+		return false
+	}
+
+	if len(r.byMonthDay) > 0 && !r.matchesMonthDay(date) {
+		return false
+	}
+
+	if len(r.byDay) > 0 {
+		return r.matchesDay(date)
+	}
+
+	// Without BYDAY or BYMONTHDAY, the series repeats start's day within
+	// each period.
+	switch r.freq {
+	case "WEEKLY":
+		return date.Weekday() == start.Weekday()
+	case "MONTHLY":
+		return len(r.byMonthDay) > 0 || date.Day() == start.Day()
+	case "YEARLY":
+		if len(r.byMonthDay) > 0 {
+			return true
+		}
+		if len(r.byMonth) == 0 && date.Month() != start.Month() {
+			return false
+		}
+		return date.Day() == start.Day()
+	default:
+		return true
+	}
+}
+
+func (r *recurrenceRule) matchesMonthDay(date time.Time) bool {
+	last := daysIn(date.Year(), date.Month())
+
+	for _, day := range r.byMonthDay {
+		if day == date.Day() || day == date.Day()-last-1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *recurrenceRule) matchesDay(date time.Time) bool {
+	for _, day := range r.byDay {
+		if day.weekday != date.Weekday() {
+			continue
+		}
+		if day.ordinal == 0 {
+			return true
+		}
+
+		// Numbered days count within the month, or within the year for
+		// yearly rules without BYMONTH.
+		index, total := date.Day(), daysIn(date.Year(), date.Month())
+		if r.freq == "YEARLY" && len(r.byMonth) == 0 {
+			index, total = date.YearDay(), time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+		}
+
+		if day.ordinal > 0 && (index-1)/7+1 == day.ordinal {
+			return true
+		}
+		if day.ordinal < 0 && -((total-index)/7+1) == day.ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// today returns the current date at midnight UTC.
+func today() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// nextOccurrences returns the next occurrences, from today on, of the series
+// that repeats recurrence from dueDate. It is null for tasks that do not
+// recur and unknown while either input is.
+func nextOccurrences(recurrence, dueDate types.String) types.List {
+	if recurrence.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+	if recurrence.IsUnknown() || dueDate.IsUnknown() {
+		return types.ListUnknown(types.StringType)
+	}
+
+	elements := []attr.Value{}
+
+	rule, err := parseRecurrence(recurrence.ValueString())
+	start, startErr := parseDueDate(dueDate.ValueString())
+	if err == nil && startErr == nil {
+		for _, date := range rule.after(start, today().AddDate(0, 0, -1), nextOccurrenceCount) {
+			elements = append(elements, types.StringValue(date.Format(dueDateLayout)))
+		}
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// recurringDueDate returns the due date to record for a task whose due date
+// in TaskMate is current. When the task recurs and current is a later
+// occurrence of the series starting at prior, that is prior, so moving on to
+// the next occurrence does not show up as a diff on due_date.
+func recurringDueDate(prior, recurrence types.String, current string) types.String {
+	if prior.IsNull() || prior.IsUnknown() || recurrence.IsNull() || recurrence.IsUnknown() {
+		return types.StringValue(current)
+	}
+
+	rule, err := parseRecurrence(recurrence.ValueString())
+	if err != nil {
+		return types.StringValue(current)
+	}
+
+	start, startErr := parseDueDate(prior.ValueString())
+	date, dateErr := parseDueDate(current)
+	if startErr != nil || dateErr != nil || !date.After(start) || !rule.contains(start, date) {
+		return types.StringValue(current)
+	}
+
+	return prior
+}
+
+var _ validator.String = recurrenceValidator{}
+
+// recurrenceValidator checks that a string is a supported RRULE.
+type recurrenceValidator struct{}
+
+func (v recurrenceValidator) Description(ctx context.Context) string {
+	return "value must be an RFC 5545 recurrence rule such as FREQ=WEEKLY;BYDAY=MO"
+}
+
+func (v recurrenceValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an RFC 5545 recurrence rule such as `FREQ=WEEKLY;BYDAY=MO`"
+}
+
+func (v recurrenceValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseRecurrence(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Recurrence Rule",
+			fmt.Sprintf("%q is not a supported RRULE: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
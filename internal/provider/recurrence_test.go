@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		rule    string
+		wantErr string
+	}{
+		{rule: "FREQ=DAILY"},
+		{rule: "RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR"},
+		{rule: "freq=monthly;byday=-1fr"},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=1,-1"},
+		{rule: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29"},
+		{rule: "FREQ=WEEKLY;INTERVAL=2;WKST=SU;COUNT=10"},
+		{rule: "FREQ=DAILY;UNTIL=20251231T000000Z"},
+		{rule: "", wantErr: "must not be empty"},
+		{rule: "INTERVAL=2", wantErr: "FREQ is required"},
+		{rule: "FREQ=HOURLY", wantErr: "no time of day"},
+		{rule: "FREQ=FORTNIGHTLY", wantErr: "FREQ must be"},
+		{rule: "FREQ=DAILY;FREQ=WEEKLY", wantErr: "more than once"},
+		{rule: "FREQ=DAILY;INTERVAL=0", wantErr: "positive integer"},
+		{rule: "FREQ=DAILY;COUNT=3;UNTIL=20251231", wantErr: "COUNT and UNTIL"},
+		{rule: "FREQ=DAILY;UNTIL=2025-12-31", wantErr: "UNTIL must be a date"},
+		{rule: "FREQ=WEEKLY;BYDAY=1MO", wantErr: "numbered BYDAY"},
+		{rule: "FREQ=MONTHLY;BYDAY=6MO", wantErr: "out of range"},
+		{rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: "BYDAY values"},
+		{rule: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: "BYMONTHDAY cannot"},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: "BYMONTHDAY values"},
+		{rule: "FREQ=YEARLY;BYMONTH=13", wantErr: "BYMONTH values"},
+		{rule: "FREQ=DAILY;BYHOUR=9", wantErr: "no time of day"},
+		{rule: "FREQ=YEARLY;BYWEEKNO=1", wantErr: "not supported"},
+		{rule: "FREQ=DAILY;FOO=1", wantErr: "unknown rule part"},
+		{rule: "FREQ", wantErr: "NAME=VALUE"},
+	}
+
+	for _, tt := range tests {
+		_, err := parseRecurrence(tt.rule)
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("parseRecurrence(%q) returned error: %s", tt.rule, err)
+		case tt.wantErr != "" && err == nil:
+			t.Errorf("parseRecurrence(%q) succeeded, want error containing %q", tt.rule, tt.wantErr)
+		case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+			t.Errorf("parseRecurrence(%q) error = %q, want it to contain %q", tt.rule, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRecurrenceRuleAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		start string
+		after string
+		n     int
+		want  []string
+	}{
+		{
+			name:  "daily",
+			rule:  "FREQ=DAILY",
+			start: "2025-01-30",
+			after: "2025-01-29",
+			n:     3,
+			want:  []string{"2025-01-30", "2025-01-31", "2025-02-01"},
+		},
+		{
+			name:  "every other week on two days",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			start: "2025-01-06",
+			after: "2025-01-06",
+			n:     4,
+			want:  []string{"2025-01-09", "2025-01-20", "2025-01-23", "2025-02-03"},
+		},
+		{
+			name:  "monthly on the start day skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: "2025-01-31",
+			after: "2025-01-31",
+			n:     3,
+			want:  []string{"2025-03-31", "2025-05-31", "2025-07-31"},
+		},
+		{
+			name:  "first Monday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=1MO",
+			start: "2025-01-06",
+			after: "2025-01-06",
+			n:     3,
+			want:  []string{"2025-02-03", "2025-03-03", "2025-04-07"},
+		},
+		{
+			name:  "last Friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR",
+			start: "2025-01-31",
+			after: "2025-01-31",
+			n:     3,
+			want:  []string{"2025-02-28", "2025-03-28", "2025-04-25"},
+		},
+		{
+			name:  "last day of the month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: "2024-01-31",
+			after: "2024-01-31",
+			n:     2,
+			want:  []string{"2024-02-29", "2024-03-31"},
+		},
+		{
+			name:  "leap day",
+			rule:  "FREQ=YEARLY",
+			start: "2024-02-29",
+			after: "2024-02-29",
+			n:     2,
+			want:  []string{"2028-02-29", "2032-02-29"},
+		},
+		{
+			name:  "count includes the start",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: "2025-01-01",
+			after: "2024-12-31",
+			n:     5,
+			want:  []string{"2025-01-01", "2025-01-02", "2025-01-03"},
+		},
+		{
+			name:  "until is inclusive",
+			rule:  "FREQ=WEEKLY;UNTIL=20250115",
+			start: "2025-01-01",
+			after: "2025-01-01",
+			n:     5,
+			want:  []string{"2025-01-08", "2025-01-15"},
+		},
+		{
+			name:  "impossible date",
+			rule:  "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			start: "2025-01-01",
+			after: "2025-01-01",
+			n:     1,
+			want:  nil,
+		},
+		{
+			name:  "daily series started decades ago",
+			rule:  "FREQ=DAILY",
+			start: "2000-01-01",
+			after: "2026-10-17",
+			n:     2,
+			want:  []string{"2026-10-18", "2026-10-19"},
+		},
+		{
+			name:  "weekly series started decades ago",
+			rule:  "FREQ=WEEKLY;INTERVAL=3;WKST=SU",
+			start: "1990-01-03",
+			after: "2026-10-17",
+			n:     1,
+			want:  []string{"2026-10-21"},
+		},
+		{
+			name:  "counted series started decades ago",
+			rule:  "FREQ=DAILY;COUNT=20000",
+			start: "1990-01-01",
+			after: "2026-10-17",
+			n:     1,
+			want:  []string{"2026-10-18"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := parseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatalf("parseRecurrence(%q): %s", tt.rule, err)
+			}
+
+			var got []string
+			for _, date := range rule.after(mustDate(t, tt.start), mustDate(t, tt.after), tt.n) {
+				got = append(got, date.Format(dueDateLayout))
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("after = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurringDueDate(t *testing.T) {
+	tests := []struct {
+		name       string
+		prior      types.String
+		recurrence types.String
+		current    string
+		want       types.String
+	}{
+		{
+			name:       "later occurrence keeps the series start",
+			prior:      types.StringValue("2025-01-06"),
+			recurrence: types.StringValue("FREQ=WEEKLY"),
+			current:    "2025-02-03",
+			want:       types.StringValue("2025-01-06"),
+		},
+		{
+			name:       "date off the series is taken",
+			prior:      types.StringValue("2025-01-06"),
+			recurrence: types.StringValue("FREQ=WEEKLY"),
+			current:    "2025-02-04",
+			want:       types.StringValue("2025-02-04"),
+		},
+		{
+			name:       "earlier date is taken",
+			prior:      types.StringValue("2025-01-06"),
+			recurrence: types.StringValue("FREQ=WEEKLY"),
+			current:    "2024-12-30",
+			want:       types.StringValue("2024-12-30"),
+		},
+		{
+			name:       "not recurring",
+			prior:      types.StringValue("2025-01-06"),
+			recurrence: types.StringNull(),
+			current:    "2025-02-03",
+			want:       types.StringValue("2025-02-03"),
+		},
+		{
+			name:       "no prior due date",
+			prior:      types.StringNull(),
+			recurrence: types.StringValue("FREQ=WEEKLY"),
+			current:    "2025-02-03",
+			want:       types.StringValue("2025-02-03"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := recurringDueDate(tt.prior, tt.recurrence, tt.current); !got.Equal(tt.want) {
+				t.Errorf("recurringDueDate = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextOccurrences(t *testing.T) {
+	if got := nextOccurrences(types.StringNull(), types.StringValue("2025-01-01")); !got.IsNull() {
+		t.Errorf("nextOccurrences without recurrence = %s, want null", got)
+	}
+	if got := nextOccurrences(types.StringValue("FREQ=DAILY"), types.StringUnknown()); !got.IsUnknown() {
+		t.Errorf("nextOccurrences with unknown due date = %s, want unknown", got)
+	}
+
+	got := nextOccurrences(types.StringValue("FREQ=DAILY"), types.StringValue("2000-01-01"))
+	if len(got.Elements()) != nextOccurrenceCount {
+		t.Fatalf("nextOccurrences returned %d dates, want %d", len(got.Elements()), nextOccurrenceCount)
+	}
+	if first := got.Elements()[0].(types.String).ValueString(); first != today().Format(dueDateLayout) {
+		t.Errorf("first occurrence = %s, want today (%s)", first, today().Format(dueDateLayout))
+	}
+}
+
+func mustDate(t *testing.T, s string) time.Time {
+	t.Helper()
+
+	date, err := parseDueDate(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return date
+}
@@ -0,0 +1,496 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TaskAttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &TaskAttachmentResource{}
+var _ resource.ResourceWithConfigValidators = &TaskAttachmentResource{}
+var _ resource.ResourceWithImportState = &TaskAttachmentResource{}
+
+func NewTaskAttachmentResource() resource.Resource {
+	return &TaskAttachmentResource{}
+}
+
+// TaskAttachmentResource defines the resource implementation.
+type TaskAttachmentResource struct {
+	client *Client
+}
+
+// TaskAttachmentResourceModel describes the resource data model.
+type TaskAttachmentResourceModel struct {
+	ID            types.Int64    `tfsdk:"id"`
+	TaskID        types.Int64    `tfsdk:"task_id"`
+	Source        types.String   `tfsdk:"source"`
+	ContentBase64 types.String   `tfsdk:"content_base64"`
+	Filename      types.String   `tfsdk:"filename"`
+	ContentType   types.String   `tfsdk:"content_type"`
+	ContentSHA256 types.String   `tfsdk:"content_sha256"`
+	Size          types.Int64    `tfsdk:"size"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TaskAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_attachment"
+}
+
+func (r *TaskAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `TaskMate task attachment resource
+
+Attaches a file to a task. The content comes from a local file (` + "`source`" + `) or
+inline (` + "`content_base64`" + `) and is streamed to TaskMate, so large files are never
+loaded into memory.
+
+The provider hashes the content at plan time into ` + "`content_sha256`" + `. The file
+is only uploaded again when its bytes change; moving it to another path is not
+a change. If the file changes between plan and apply, the apply fails.
+A ` + "`source`" + ` that does not exist yet at plan time, such as one written by
+another resource in the same apply, is hashed while it is uploaded instead.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "taskmate_task_attachment" "runbook" {
+  task_id = taskmate_task.deploy.task_id
+  source  = "${path.module}/runbook.pdf"
+}
+
+resource "taskmate_task_attachment" "diff" {
+  task_id        = taskmate_task.deploy.task_id
+  filename       = "plan.txt"
+  content_base64 = base64encode(var.plan_summary)
+}
+` + "```" + `
+
+## Import
+
+Attachments are imported by ` + "`<task_id>/<attachment_id>`" + `. Set ` + "`source`" + ` or
+` + "`content_base64`" + ` to the same content afterwards, or the next apply uploads it again:
+
+` + "```bash" + `
+terraform import taskmate_task_attachment.runbook 1/3
+` + "```" + `
+`,
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Attachment identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the task to attach the file to. Changing this forces a new attachment",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path of a local file to upload. Exactly one of `source` and `content_base64` must be set",
+				Optional:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded content to upload. Exactly one of `source` and `content_base64` must be set",
+				Optional:            true,
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "File name shown in TaskMate. Defaults to the base name of `source`, or `attachment`. Changing this forces a new attachment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "MIME type of the content. Defaults to a type guessed from `filename`. Changing this forces a new attachment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				MarkdownDescription: "Hex-encoded SHA-256 of the content. A change forces a new upload",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Content size in bytes",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Upload timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TaskAttachmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("source"),
+			path.MatchRoot("content_base64"),
+		),
+	}
+}
+
+func (r *TaskAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the content so a new upload is planned only when the
+// bytes change, and fills in the default filename and content type.
+func (r *TaskAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TaskAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TaskAttachmentResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	sum, size, err := attachmentHash(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attachmentContentPath(plan), "Unable to Read Attachment Content", err.Error())
+		return
+	}
+	plan.ContentSHA256 = sum
+	plan.Size = size
+
+	if plan.Filename.IsUnknown() {
+		switch {
+		case !state.Filename.IsNull():
+			plan.Filename = state.Filename
+		case plan.Source.IsUnknown():
+			// Wait for the path to be known.
+		default:
+			plan.Filename = types.StringValue(attachmentFilename(plan))
+		}
+	}
+
+	if plan.ContentType.IsUnknown() && !plan.Filename.IsUnknown() {
+		if !state.ContentType.IsNull() && plan.Filename.Equal(state.Filename) {
+			plan.ContentType = state.ContentType
+		} else {
+			plan.ContentType = types.StringValue(guessContentType(plan.Filename.ValueString()))
+		}
+	}
+
+	if !req.State.Raw.IsNull() && !plan.ContentSHA256.Equal(state.ContentSHA256) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *TaskAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TaskAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Defaults that waited for an unknown source at plan time.
+	if data.Filename.IsUnknown() {
+		data.Filename = types.StringValue(attachmentFilename(data))
+	}
+	if data.ContentType.IsUnknown() {
+		data.ContentType = types.StringValue(guessContentType(data.Filename.ValueString()))
+	}
+
+	content, err := attachmentContent(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attachmentContentPath(data), "Unable to Read Attachment Content", err.Error())
+		return
+	}
+	defer content.Close()
+
+	// Hash the bytes as they are uploaded to catch changes since the plan.
+	hash := sha256.New()
+	counter := &countingWriter{}
+	body := io.TeeReader(content, io.MultiWriter(hash, counter))
+
+	taskID := int(data.TaskID.ValueInt64())
+
+	attachment, err := r.client.UploadAttachment(ctx, taskID, data.Filename.ValueString(), data.ContentType.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload attachment, got error: %s", err))
+		return
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if !data.ContentSHA256.IsUnknown() && sum != data.ContentSHA256.ValueString() {
+		// Don't leave an attachment behind that Terraform does not track.
+		if err := r.client.DeleteAttachment(ctx, taskID, attachment.ID); err != nil {
+			resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to remove attachment %d after a failed upload, got error: %s", attachment.ID, err))
+		}
+		resp.Diagnostics.AddAttributeError(
+			attachmentContentPath(data),
+			"Attachment Content Changed",
+			fmt.Sprintf("The content changed between plan and apply (planned SHA-256 %s, uploaded %s). Run terraform apply again.",
+				data.ContentSHA256.ValueString(), sum),
+		)
+		return
+	}
+
+	data.ID = types.Int64Value(int64(attachment.ID))
+	data.ContentSHA256 = types.StringValue(sum)
+	data.Size = types.Int64Value(counter.n)
+	data.CreatedAt = types.StringValue(attachment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TaskAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachment, err := r.client.GetAttachment(ctx, int(data.TaskID.ValueInt64()), int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attachment, got error: %s", err))
+		return
+	}
+
+	data.Filename = types.StringValue(attachment.Filename)
+	if attachment.ContentType != "" {
+		data.ContentType = types.StringValue(attachment.ContentType)
+	}
+	// Servers that report a hash let the next plan spot content replaced
+	// outside Terraform.
+	if attachment.SHA256 != "" {
+		data.ContentSHA256 = types.StringValue(attachment.SHA256)
+	}
+	data.Size = types.Int64Value(attachment.Size)
+	data.CreatedAt = types.StringValue(attachment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only records a new source or encoding of unchanged content; any
+// change to the bytes plans a replacement instead.
+func (r *TaskAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TaskAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A source that did not exist yet at plan time is hashed now.
+	if data.ContentSHA256.IsUnknown() || data.Size.IsUnknown() {
+		content, err := attachmentContent(data)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(attachmentContentPath(data), "Unable to Read Attachment Content", err.Error())
+			return
+		}
+
+		sum, size, err := hashContent(content)
+		content.Close()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(attachmentContentPath(data), "Unable to Read Attachment Content", err.Error())
+			return
+		}
+
+		data.ContentSHA256 = types.StringValue(sum)
+		data.Size = types.Int64Value(size)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TaskAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAttachment(ctx, int(data.TaskID.ValueInt64()), int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete attachment, got error: %s", err))
+		return
+	}
+}
+
+func (r *TaskAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	taskID, attachmentID, err := parseNestedImportID(req.ID, "attachment")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import %q: %s. Expected <task_id>/<attachment_id> with positive integer IDs.", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("task_id"), int64(taskID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(attachmentID))...)
+}
+
+// attachmentContent opens the configured content as a stream.
+func attachmentContent(data TaskAttachmentResourceModel) (io.ReadCloser, error) {
+	if !data.Source.IsNull() {
+		return os.Open(data.Source.ValueString())
+	}
+
+	decoder := base64.NewDecoder(base64.StdEncoding, strings.NewReader(data.ContentBase64.ValueString()))
+	return io.NopCloser(decoder), nil
+}
+
+// attachmentHash returns the hex-encoded SHA-256 and size of the configured
+// content. Both are unknown while the content cannot be read yet: its source
+// is unknown, or the file does not exist because another resource writes it
+// during the same apply.
+func attachmentHash(data TaskAttachmentResourceModel) (types.String, types.Int64, error) {
+	if data.Source.IsUnknown() || data.ContentBase64.IsUnknown() {
+		return types.StringUnknown(), types.Int64Unknown(), nil
+	}
+
+	content, err := attachmentContent(data)
+	if errors.Is(err, fs.ErrNotExist) {
+		return types.StringUnknown(), types.Int64Unknown(), nil
+	}
+	if err != nil {
+		return types.StringNull(), types.Int64Null(), err
+	}
+	defer content.Close()
+
+	sum, size, err := hashContent(content)
+	if err != nil {
+		return types.StringNull(), types.Int64Null(), err
+	}
+
+	return types.StringValue(sum), types.Int64Value(size), nil
+}
+
+// attachmentFilename returns the default file name: the base name of the
+// source, or "attachment" for inline content.
+func attachmentFilename(data TaskAttachmentResourceModel) string {
+	if !data.Source.IsNull() {
+		return filepath.Base(data.Source.ValueString())
+	}
+	return "attachment"
+}
+
+// attachmentContentPath returns the attribute the content comes from, for
+// diagnostics.
+func attachmentContentPath(data TaskAttachmentResourceModel) path.Path {
+	if !data.Source.IsNull() {
+		return path.Root("source")
+	}
+	return path.Root("content_base64")
+}
+
+// hashContent returns the hex-encoded SHA-256 and size of r's content.
+func hashContent(r io.Reader) (string, int64, error) {
+	hash := sha256.New()
+
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// guessContentType returns the MIME type for filename's extension, falling
+// back to application/octet-stream.
+func guessContentType(filename string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(filename)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttachmentHash(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "runbook.txt")
+	if err := os.WriteFile(existing, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// SHA-256 of "hello".
+	helloSum := types.StringValue("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")
+
+	tests := []struct {
+		name     string
+		data     TaskAttachmentResourceModel
+		wantSum  types.String
+		wantSize types.Int64
+		wantErr  bool
+	}{
+		{
+			name:     "file",
+			data:     TaskAttachmentResourceModel{Source: types.StringValue(existing), ContentBase64: types.StringNull()},
+			wantSum:  helloSum,
+			wantSize: types.Int64Value(5),
+		},
+		{
+			name:     "inline",
+			data:     TaskAttachmentResourceModel{Source: types.StringNull(), ContentBase64: types.StringValue("aGVsbG8=")},
+			wantSum:  helloSum,
+			wantSize: types.Int64Value(5),
+		},
+		{
+			name:     "file written during apply",
+			data:     TaskAttachmentResourceModel{Source: types.StringValue(filepath.Join(dir, "missing.txt")), ContentBase64: types.StringNull()},
+			wantSum:  types.StringUnknown(),
+			wantSize: types.Int64Unknown(),
+		},
+		{
+			name:     "unknown source",
+			data:     TaskAttachmentResourceModel{Source: types.StringUnknown(), ContentBase64: types.StringNull()},
+			wantSum:  types.StringUnknown(),
+			wantSize: types.Int64Unknown(),
+		},
+		{
+			name:    "unreadable source",
+			data:    TaskAttachmentResourceModel{Source: types.StringValue(dir), ContentBase64: types.StringNull()},
+			wantErr: true,
+		},
+		{
+			name:    "invalid base64",
+			data:    TaskAttachmentResourceModel{Source: types.StringNull(), ContentBase64: types.StringValue("not base64!")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, size, err := attachmentHash(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("attachmentHash = %s, %s, want error", sum, size)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("attachmentHash returned error: %s", err)
+			}
+			if !sum.Equal(tt.wantSum) || !size.Equal(tt.wantSize) {
+				t.Errorf("attachmentHash = %s, %s, want %s, %s", sum, size, tt.wantSum, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestAttachmentFilename(t *testing.T) {
+	tests := []struct {
+		source types.String
+		want   string
+	}{
+		{source: types.StringValue("build/out/report.pdf"), want: "report.pdf"},
+		{source: types.StringValue("report.pdf"), want: "report.pdf"},
+		{source: types.StringNull(), want: "attachment"},
+	}
+
+	for _, tt := range tests {
+		got := attachmentFilename(TaskAttachmentResourceModel{Source: tt.source})
+		if got != tt.want {
+			t.Errorf("attachmentFilename(%s) = %q, want %q", tt.source, got, tt.want)
+		}
+	}
+}
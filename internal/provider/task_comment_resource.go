@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TaskCommentResource{}
+var _ resource.ResourceWithImportState = &TaskCommentResource{}
+
+func NewTaskCommentResource() resource.Resource {
+	return &TaskCommentResource{}
+}
+
+// TaskCommentResource defines the resource implementation.
+type TaskCommentResource struct {
+	client *Client
+}
+
+// TaskCommentResourceModel describes the resource data model.
+type TaskCommentResourceModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	TaskID    types.Int64  `tfsdk:"task_id"`
+	Body      types.String `tfsdk:"body"`
+	Author    types.String `tfsdk:"author"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (r *TaskCommentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_comment"
+}
+
+func (r *TaskCommentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `TaskMate task comment resource
+
+Adds a comment to a task, for example to attach a plan summary or a link to the
+pipeline run that created it.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "taskmate_task_comment" "plan" {
+  task_id = taskmate_task.deploy.task_id
+  body    = "Planned by ${var.run_url}"
+}
+` + "```" + `
+
+## Import
+
+Comments are imported by ` + "`<task_id>/<comment_id>`" + `:
+
+` + "```bash" + `
+terraform import taskmate_task_comment.plan 1/12
+` + "```" + `
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Comment identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the task to comment on. Changing this forces a new comment",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "Comment text",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "Username of the comment's author",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TaskCommentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TaskCommentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TaskCommentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comment, err := r.client.CreateComment(ctx, int(data.TaskID.ValueInt64()), data.Body.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create comment, got error: %s", err))
+		return
+	}
+
+	setTaskCommentResourceModel(&data, comment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskCommentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TaskCommentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comment, err := r.client.GetComment(ctx, int(data.TaskID.ValueInt64()), int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read comment, got error: %s", err))
+		return
+	}
+
+	setTaskCommentResourceModel(&data, comment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskCommentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TaskCommentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comment, err := r.client.UpdateComment(ctx, int(data.TaskID.ValueInt64()), int(data.ID.ValueInt64()), data.Body.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update comment, got error: %s", err))
+		return
+	}
+
+	setTaskCommentResourceModel(&data, comment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskCommentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TaskCommentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteComment(ctx, int(data.TaskID.ValueInt64()), int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete comment, got error: %s", err))
+		return
+	}
+}
+
+func (r *TaskCommentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	taskID, commentID, err := parseNestedImportID(req.ID, "comment")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to import %q: %s. Expected <task_id>/<comment_id> with positive integer IDs.", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("task_id"), int64(taskID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(commentID))...)
+}
+
+// setTaskCommentResourceModel copies the API's view of a comment into data.
+func setTaskCommentResourceModel(data *TaskCommentResourceModel, comment *Comment) {
+	data.ID = types.Int64Value(int64(comment.ID))
+	// Not every server echoes the task ID back.
+	if comment.TaskID != 0 {
+		data.TaskID = types.Int64Value(int64(comment.TaskID))
+	}
+	data.Body = types.StringValue(comment.Body)
+	data.Author = types.StringValue(comment.Author)
+	data.CreatedAt = types.StringValue(comment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(comment.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
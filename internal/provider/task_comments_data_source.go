@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TaskCommentsDataSource{}
+
+func NewTaskCommentsDataSource() datasource.DataSource {
+	return &TaskCommentsDataSource{}
+}
+
+// TaskCommentsDataSource defines the data source implementation.
+type TaskCommentsDataSource struct {
+	client *Client
+}
+
+// TaskCommentsDataSourceModel describes the data source data model.
+type TaskCommentsDataSourceModel struct {
+	ID       types.String       `tfsdk:"id"`
+	TaskID   types.Int64        `tfsdk:"task_id"`
+	Limit    types.Int64        `tfsdk:"limit"`
+	Comments []TaskCommentModel `tfsdk:"comments"`
+}
+
+// TaskCommentModel describes a comment in the comments list.
+type TaskCommentModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Body      types.String `tfsdk:"body"`
+	Author    types.String `tfsdk:"author"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *TaskCommentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_comments"
+}
+
+func (d *TaskCommentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "TaskMate task comments data source - lists a task's comments, oldest first",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier",
+				Computed:            true,
+			},
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the task whose comments to list",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of comments to return. Defaults to all of them",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"comments": schema.ListNestedAttribute{
+				MarkdownDescription: "The task's comments, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Comment identifier",
+							Computed:            true,
+						},
+						"body": schema.StringAttribute{
+							MarkdownDescription: "Comment text",
+							Computed:            true,
+						},
+						"author": schema.StringAttribute{
+							MarkdownDescription: "Username of the comment's author",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last update timestamp",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TaskCommentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TaskCommentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TaskCommentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskID := int(data.TaskID.ValueInt64())
+
+	comments, err := d.client.ListComments(ctx, taskID, int(data.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list comments, got error: %s", err))
+		return
+	}
+
+	data.Comments = make([]TaskCommentModel, len(comments))
+	for i, comment := range comments {
+		data.Comments[i] = TaskCommentModel{
+			ID:        types.Int64Value(int64(comment.ID)),
+			Body:      types.StringValue(comment.Body),
+			Author:    types.StringValue(comment.Author),
+			CreatedAt: types.StringValue(comment.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
+			UpdatedAt: types.StringValue(comment.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
+		}
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(taskID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
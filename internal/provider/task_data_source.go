@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,14 +24,24 @@ type TaskDataSource struct {
 
 // TaskDataSourceModel describes the data source data model.
 type TaskDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Title       types.String `tfsdk:"title"`
-	Description types.String `tfsdk:"description"`
-	DueDate     types.String `tfsdk:"due_date"`
-	Priority    types.String `tfsdk:"priority"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID               types.String `tfsdk:"id"`
+	Title            types.String `tfsdk:"title"`
+	Description      types.String `tfsdk:"description"`
+	DueDate          types.String `tfsdk:"due_date"`
+	Priority         types.String `tfsdk:"priority"`
+	Status           types.String `tfsdk:"status"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Labels           types.Set    `tfsdk:"labels"`
+	ProjectID        types.Int64  `tfsdk:"project_id"`
+	WorkflowID       types.Int64  `tfsdk:"workflow_id"`
+	EstimateMinutes  types.Int64  `tfsdk:"estimate_minutes"`
+	TimeSpentMinutes types.Int64  `tfsdk:"time_spent_minutes"`
+	CompletedAt      types.String `tfsdk:"completed_at"`
+	Assignee         types.String `tfsdk:"assignee"`
+	Watchers         types.Set    `tfsdk:"watchers"`
+	BlockedBy        types.List   `tfsdk:"blocked_by"`
+	Blocking         types.List   `tfsdk:"blocking"`
 }
 
 func (d *TaskDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -74,6 +85,50 @@ func (d *TaskDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Last update timestamp",
 				Computed:            true,
 			},
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "Task labels",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the project the task belongs to, if any",
+				Computed:            true,
+			},
+			"workflow_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the workflow the task follows, if any",
+				Computed:            true,
+			},
+			"estimate_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Estimated effort in minutes, if any",
+				Computed:            true,
+			},
+			"time_spent_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Time logged against the task, in minutes",
+				Computed:            true,
+			},
+			"completed_at": schema.StringAttribute{
+				MarkdownDescription: "When the task was last moved to `completed`, if it is completed",
+				Computed:            true,
+			},
+			"assignee": schema.StringAttribute{
+				MarkdownDescription: "Username of the user the task is assigned to, if any",
+				Computed:            true,
+			},
+			"watchers": schema.SetAttribute{
+				MarkdownDescription: "Usernames of the users watching the task",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"blocked_by": schema.ListAttribute{
+				MarkdownDescription: "IDs of the tasks that block this task",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"blocking": schema.ListAttribute{
+				MarkdownDescription: "IDs of the tasks this task blocks",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -105,19 +160,24 @@ func (d *TaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	var id int
-	_, err := fmt.Sscanf(data.ID.ValueString(), "%d", &id)
+	id, err := parseTaskID(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse task ID: %s", err))
 		return
 	}
 
-	task, err := d.client.GetTask(id)
+	task, err := d.client.GetTask(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read task, got error: %s", err))
 		return
 	}
 
+	deps, err := d.client.ListDependencies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list task dependencies, got error: %s", err))
+		return
+	}
+
 	data.Title = types.StringValue(task.Title)
 	data.Description = types.StringValue(task.Description)
 	data.DueDate = types.StringValue(task.DueDate)
@@ -125,6 +185,25 @@ func (d *TaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	data.Status = types.StringValue(task.Status)
 	data.CreatedAt = types.StringValue(task.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	data.UpdatedAt = types.StringValue(task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.Labels = labelSet(mergeLabels(task.Labels))
+	data.ProjectID = taskProjectID(task)
+	data.WorkflowID = taskWorkflowID(task)
+	data.EstimateMinutes = taskEstimate(task)
+	data.TimeSpentMinutes = types.Int64Value(int64(task.TimeSpentMinutes))
+	data.CompletedAt = taskCompletedAt(task)
+	data.Assignee = taskAssignee(task)
+	data.Watchers = labelSet(mergeLabels(task.Watchers))
+
+	blockedBy, blocking := taskBlockers(deps, task.ID)
+	var diags diag.Diagnostics
+	data.BlockedBy, diags = types.ListValueFrom(ctx, types.Int64Type, blockedBy)
+	resp.Diagnostics.Append(diags...)
+	data.Blocking, diags = types.ListValueFrom(ctx, types.Int64Type, blocking)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
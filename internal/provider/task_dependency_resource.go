@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TaskDependencyResource{}
+var _ resource.ResourceWithImportState = &TaskDependencyResource{}
+var _ resource.ResourceWithModifyPlan = &TaskDependencyResource{}
+
+func NewTaskDependencyResource() resource.Resource {
+	return &TaskDependencyResource{}
+}
+
+// TaskDependencyResource defines the resource implementation.
+type TaskDependencyResource struct {
+	client *Client
+}
+
+// TaskDependencyResourceModel describes the resource data model.
+type TaskDependencyResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	TaskID          types.Int64  `tfsdk:"task_id"`
+	DependsOnTaskID types.Int64  `tfsdk:"depends_on_task_id"`
+	Type            types.String `tfsdk:"type"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+func (r *TaskDependencyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_dependency"
+}
+
+func (r *TaskDependencyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `TaskMate task dependency resource
+
+Records that one task depends on another. A ` + "`blocks`" + ` dependency means
+` + "`task_id`" + ` cannot be finished before ` + "`depends_on_task_id`" + `; a ` + "`relates`" + ` dependency
+only links the two tasks.
+
+When both task IDs are known, the plan fails if a new blocking dependency would
+close a cycle with the dependencies already in TaskMate. Cycles made up only of
+dependencies created in the same apply are caught when they are created.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "taskmate_task" "migrate" {
+  title = "Run migrations"
+}
+
+resource "taskmate_task" "deploy" {
+  title = "Deploy"
+}
+
+resource "taskmate_task_dependency" "deploy_after_migrate" {
+  task_id            = taskmate_task.deploy.task_id
+  depends_on_task_id = taskmate_task.migrate.task_id
+}
+` + "```" + `
+
+## Import
+
+` + "```bash" + `
+terraform import taskmate_task_dependency.deploy_after_migrate 7
+` + "```" + `
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Dependency identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the dependent task. Changing this forces a new dependency",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"depends_on_task_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the task `task_id` depends on. Changing this forces a new dependency",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Dependency type (blocks, relates). Defaults to `blocks`. Changing this forces a new dependency",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(dependencyTypeBlocks),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dependencyTypeBlocks, dependencyTypeRelates),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TaskDependencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects dependencies that would close a cycle, as soon as both
+// task IDs are known.
+func (r *TaskDependencyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TaskDependencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TaskID.IsUnknown() || plan.DependsOnTaskID.IsUnknown() || plan.Type.IsUnknown() {
+		return
+	}
+
+	ignoreID := 0
+	if !req.State.Raw.IsNull() {
+		var state TaskDependencyResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// An unchanged dependency was checked when it was created.
+		if plan.TaskID.Equal(state.TaskID) && plan.DependsOnTaskID.Equal(state.DependsOnTaskID) && plan.Type.Equal(state.Type) {
+			return
+		}
+		ignoreID = int(state.ID.ValueInt64())
+	}
+
+	// The provider is not configured yet, e.g. during validation.
+	if r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkCycle(ctx, plan, ignoreID)...)
+}
+
+// checkCycle returns an error if the planned dependency points at its own
+// task or would close a cycle of blocking dependencies.
+func (r *TaskDependencyResource) checkCycle(ctx context.Context, plan TaskDependencyResourceModel, ignoreID int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	taskID := int(plan.TaskID.ValueInt64())
+	dependsOn := int(plan.DependsOnTaskID.ValueInt64())
+
+	if taskID == dependsOn {
+		diags.AddAttributeError(
+			path.Root("depends_on_task_id"),
+			"Invalid Task Dependency",
+			fmt.Sprintf("Task %d cannot depend on itself.", taskID),
+		)
+		return diags
+	}
+
+	if plan.Type.ValueString() != dependencyTypeBlocks {
+		return diags
+	}
+
+	deps, err := r.client.ListDependencies(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list task dependencies, got error: %s", err))
+		return diags
+	}
+
+	if cycle := dependencyCycle(deps, taskID, dependsOn, ignoreID); cycle != nil {
+		diags.AddAttributeError(
+			path.Root("depends_on_task_id"),
+			"Task Dependency Cycle",
+			fmt.Sprintf("Making task %d depend on task %d would create the dependency cycle %s. "+
+				"Remove one of the dependencies in the cycle first.", taskID, dependsOn, formatCycle(cycle)),
+		)
+	}
+
+	return diags
+}
+
+func (r *TaskDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TaskDependencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check again: task IDs may only have become known during apply, and
+	// dependencies created earlier in this apply were not visible at plan time.
+	resp.Diagnostics.Append(r.checkCycle(ctx, data, 0)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dep, err := r.client.CreateDependency(ctx, int(data.TaskID.ValueInt64()), int(data.DependsOnTaskID.ValueInt64()), data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create task dependency, got error: %s", err))
+		return
+	}
+
+	setTaskDependencyResourceModel(&data, dep)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TaskDependencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dep, err := r.client.GetDependency(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read task dependency, got error: %s", err))
+		return
+	}
+
+	setTaskDependencyResourceModel(&data, dep)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with API changes, since every attribute forces a
+// new dependency.
+func (r *TaskDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TaskDependencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaskDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TaskDependencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDependency(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete task dependency, got error: %s", err))
+		return
+	}
+}
+
+func (r *TaskDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil || id <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Dependency ID must be a positive integer, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setTaskDependencyResourceModel copies the API's view of a dependency into
+// data.
+func setTaskDependencyResourceModel(data *TaskDependencyResourceModel, dep *Dependency) {
+	data.ID = types.Int64Value(int64(dep.ID))
+	data.TaskID = types.Int64Value(int64(dep.TaskID))
+	data.DependsOnTaskID = types.Int64Value(int64(dep.DependsOnTaskID))
+	data.Type = types.StringValue(dep.Type)
+	data.CreatedAt = types.StringValue(dep.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
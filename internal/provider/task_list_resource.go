@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &TaskListResource{}
+var _ list.ListResourceWithConfigure = &TaskListResource{}
+
+func NewTaskListResource() list.ListResource {
+	return &TaskListResource{}
+}
+
+// TaskListResource defines the list resource implementation.
+type TaskListResource struct {
+	client *Client
+}
+
+// TaskListResourceModel describes the list resource data model.
+type TaskListResourceModel struct {
+	Status   types.String `tfsdk:"status"`
+	Priority types.String `tfsdk:"priority"`
+	Title    types.String `tfsdk:"title"`
+}
+
+func (r *TaskListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task"
+}
+
+func (r *TaskListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists TaskMate tasks for `terraform query`, optionally filtered",
+
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list tasks with this status",
+				Optional:            true,
+			},
+			"priority": schema.StringAttribute{
+				MarkdownDescription: "Only list tasks with this priority",
+				Optional:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Only list tasks whose title contains this string, ignoring case",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *TaskListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TaskListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data TaskListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tasks, err := r.client.ListTasks(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list tasks, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := TaskFilter{
+		Status:   data.Status.ValueString(),
+		Priority: data.Priority.ValueString(),
+		Title:    data.Title.ValueString(),
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, task := range tasks {
+			if !filter.Match(task) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = fmt.Sprintf("%s (#%d)", task.Title, task.ID)
+
+			result.Diagnostics.Append(result.Identity.Set(ctx, TaskResourceIdentityModel{
+				Host: types.StringValue(r.client.Host),
+				ID:   types.Int64Value(int64(task.ID)),
+			})...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, taskListResourceModel(task, r.client.DefaultLabels))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// taskListResourceModel returns the taskmate_task state for a listed task,
+// used by Terraform to generate configuration.
+func taskListResourceModel(task *Task, defaultLabels []string) TaskResourceModel {
+	data := TaskResourceModel{
+		ExternalID:     types.StringNull(),
+		AdoptExisting:  types.BoolValue(false),
+		DeletionPolicy: types.StringValue(deletionPolicyDelete),
+		Labels:         types.SetNull(types.StringType),
+		Watchers:       types.SetNull(types.StringType),
+		Recurrence:     types.StringNull(),
+		RecurrenceMode: types.StringValue(recurrenceModeServer),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"read":   types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+	setTaskResourceModel(&data, task)
+
+	// Labels the provider would add anyway stay out of the configuration.
+	var labels []string
+	for _, label := range mergeLabels(task.Labels) {
+		if !slices.Contains(defaultLabels, label) {
+			labels = append(labels, label)
+		}
+	}
+	if len(labels) > 0 {
+		data.Labels = labelSet(labels)
+	}
+	if len(task.Watchers) > 0 {
+		data.Watchers = labelSet(mergeLabels(task.Watchers))
+	}
+	if task.Recurrence != "" {
+		data.Recurrence = types.StringValue(task.Recurrence)
+	}
+	data.NextOccurrences = nextOccurrences(data.Recurrence, data.DueDate)
+
+	return data
+}
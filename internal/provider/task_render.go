@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// taskRows flattens a list of task objects, such as the tasks attribute of
+// the taskmate_tasks data source, into maps of attribute name to string.
+// Objects may carry any subset of attributes; missing or null ones read as
+// empty strings.
+func taskRows(ctx context.Context, value types.Dynamic) ([]map[string]string, error) {
+	var elements []attr.Value
+
+	switch v := value.UnderlyingValue().(type) {
+	case basetypes.ListValue:
+		elements = v.Elements()
+	case basetypes.TupleValue:
+		elements = v.Elements()
+	case basetypes.SetValue:
+		elements = v.Elements()
+	case nil:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("expected a list of task objects, got %s", v.Type(ctx))
+	}
+
+	rows := make([]map[string]string, 0, len(elements))
+	for i, element := range elements {
+		var attrs map[string]attr.Value
+
+		switch e := element.(type) {
+		case basetypes.ObjectValue:
+			attrs = e.Attributes()
+		case basetypes.MapValue:
+			attrs = e.Elements()
+		default:
+			return nil, fmt.Errorf("element %d: expected a task object, got %s", i, element.Type(ctx))
+		}
+
+		row := make(map[string]string, len(attrs))
+		for name, value := range attrs {
+			s, err := attrString(value)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: attribute %q: %w", i, name, err)
+			}
+			row[name] = s
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// attrString renders an attribute value as a string. Lists and sets of
+// scalars, such as labels, are joined with commas.
+func attrString(value attr.Value) (string, error) {
+	if value.IsNull() {
+		return "", nil
+	}
+	if value.IsUnknown() {
+		return "", fmt.Errorf("value is not yet known")
+	}
+
+	switch v := value.(type) {
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.NumberValue:
+		return v.ValueBigFloat().Text('f', -1), nil
+	case basetypes.Int64Value:
+		return strconv.FormatInt(v.ValueInt64(), 10), nil
+	case basetypes.Float64Value:
+		return strconv.FormatFloat(v.ValueFloat64(), 'f', -1, 64), nil
+	case basetypes.BoolValue:
+		return strconv.FormatBool(v.ValueBool()), nil
+	case basetypes.DynamicValue:
+		return attrString(v.UnderlyingValue())
+	case basetypes.ListValue:
+		return joinAttrStrings(v.Elements(), false)
+	case basetypes.TupleValue:
+		return joinAttrStrings(v.Elements(), false)
+	case basetypes.SetValue:
+		return joinAttrStrings(v.Elements(), true)
+	default:
+		// Non-scalar attributes are ignored rather than rejected so whole
+		// data source objects can be passed in.
+		return "", nil
+	}
+}
+
+// joinAttrStrings renders each element and joins them with ", ". Set
+// elements are sorted so output does not depend on their order.
+func joinAttrStrings(elements []attr.Value, sorted bool) (string, error) {
+	parts := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, err := attrString(element)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, s)
+	}
+	if sorted {
+		slices.Sort(parts)
+	}
+
+	return strings.Join(parts, ", "), nil
+}
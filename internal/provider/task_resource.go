@@ -2,19 +2,54 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TaskResource{}
 var _ resource.ResourceWithImportState = &TaskResource{}
+var _ resource.ResourceWithModifyPlan = &TaskResource{}
+var _ resource.ResourceWithIdentity = &TaskResource{}
+var _ resource.ResourceWithUpgradeState = &TaskResource{}
+
+// Default operation timeouts, used when the timeouts block leaves one unset.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
+// Deletion policies control what happens to the task on destroy.
+const (
+	deletionPolicyDelete   = "delete"
+	deletionPolicyComplete = "complete"
+	deletionPolicyArchive  = "archive"
+	deletionPolicyAbandon  = "abandon"
+)
 
 func NewTaskResource() resource.Resource {
 	return &TaskResource{}
@@ -27,14 +62,39 @@ type TaskResource struct {
 
 // TaskResourceModel describes the resource data model.
 type TaskResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Title       types.String `tfsdk:"title"`
-	Description types.String `tfsdk:"description"`
-	DueDate     types.String `tfsdk:"due_date"`
-	Priority    types.String `tfsdk:"priority"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID                types.String   `tfsdk:"id"`
+	TaskID            types.Int64    `tfsdk:"task_id"`
+	Title             types.String   `tfsdk:"title"`
+	Description       types.String   `tfsdk:"description"`
+	DueDate           types.String   `tfsdk:"due_date"`
+	Priority          types.String   `tfsdk:"priority"`
+	Status            types.String   `tfsdk:"status"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	UpdatedAt         types.String   `tfsdk:"updated_at"`
+	ExternalID        types.String   `tfsdk:"external_id"`
+	AdoptExisting     types.Bool     `tfsdk:"adopt_existing"`
+	DeletionPolicy    types.String   `tfsdk:"deletion_policy"`
+	Labels            types.Set      `tfsdk:"labels"`
+	EffectiveLabels   types.Set      `tfsdk:"effective_labels"`
+	ProjectID         types.Int64    `tfsdk:"project_id"`
+	Checklist         types.List     `tfsdk:"checklist"`
+	ChecklistProgress types.Int64    `tfsdk:"checklist_progress"`
+	Assignee          types.String   `tfsdk:"assignee"`
+	Watchers          types.Set      `tfsdk:"watchers"`
+	Recurrence        types.String   `tfsdk:"recurrence"`
+	RecurrenceMode    types.String   `tfsdk:"recurrence_mode"`
+	NextOccurrences   types.List     `tfsdk:"next_occurrences"`
+	WorkflowID        types.Int64    `tfsdk:"workflow_id"`
+	EstimateMinutes   types.Int64    `tfsdk:"estimate_minutes"`
+	TimeSpentMinutes  types.Int64    `tfsdk:"time_spent_minutes"`
+	CompletedAt       types.String   `tfsdk:"completed_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// TaskResourceIdentityModel describes the resource identity data model.
+type TaskResourceIdentityModel struct {
+	Host types.String `tfsdk:"host"`
+	ID   types.Int64  `tfsdk:"id"`
 }
 
 func (r *TaskResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +103,8 @@ func (r *TaskResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *TaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
+
 		MarkdownDescription: `TaskMate task resource
 
 Manages a task in the TaskMate application.
@@ -61,27 +123,205 @@ resource "taskmate_task" "example" {
 
 ## Import
 
-Tasks can be imported using their ID. To find task IDs, use the ` + "`taskmate_tasks`" + ` data source or query the API directly.
+Tasks can be imported by ID, by ` + "`<host>/<id>`" + `, by exact title or by external ID.
+Title and external ID lookups fail if more than one task matches.
 
 ` + "```bash" + `
-# List all tasks to find IDs
-terraform apply -target=data.taskmate_tasks.all
-
 # Import a task by ID
 terraform import taskmate_task.example 1
+
+# Import a task by ID, checking it lives on the configured host
+terraform import taskmate_task.example http://localhost:8080/1
+
+# Import a task by its exact title or external ID
+terraform import taskmate_task.example 'title:Deploy application'
+terraform import taskmate_task.example external_id:infra/deploy
+` + "```" + `
+
+With Terraform 1.12 or later, tasks can also be imported by resource identity:
+
+` + "```hcl" + `
+import {
+  to = taskmate_task.example
+  identity = {
+    id = 1
+  }
+}
 ` + "```" + `
 
 See the examples/import directory for detailed import workflows.
+
+## Adopting Existing Tasks
+
+Creates are sent with an ` + "`Idempotency-Key`" + ` derived from the task's fields, so
+retrying a create that timed out, or re-running the apply, does not make a
+duplicate. Tasks with identical fields, such as those made with ` + "`count`" + `, share
+a key and are created only once; give each a distinct ` + "`external_id`" + `. Set
+` + "`adopt_existing = true`" + ` to go further: before creating, the provider looks for a
+task with the same title (and the same ` + "`external_id`" + `, if set) and takes it over
+instead, updating it to match the configuration.
+
+` + "```hcl" + `
+resource "taskmate_task" "example" {
+  title          = "Rotate certificates"
+  external_id    = "infra/rotate-certs"
+  adopt_existing = true
+}
+` + "```" + `
+
+## Labels
+
+` + "`labels`" + ` holds the labels set in this resource. Labels from the provider's
+` + "`default_labels`" + ` block are added on top, and the full set sent to TaskMate is
+reported in ` + "`effective_labels`" + `. Changing the defaults updates every task but never
+shows up as a diff on ` + "`labels`" + `.
+
+` + "```hcl" + `
+provider "taskmate" {
+  default_labels {
+    labels = ["team:infra"]
+  }
+}
+
+resource "taskmate_task" "example" {
+  title  = "Rotate certificates"
+  labels = ["env:prod"]
+}
+` + "```" + `
+
+## Checklist
+
+` + "`checklist`" + ` holds an ordered list of items. Items are matched to the ones
+already on the task by text, then by position, so editing, ticking or reordering
+items updates them in place. Leave ` + "`done`" + ` unset to tick items in TaskMate
+without causing a diff, and leave ` + "`checklist`" + ` out entirely to manage it only in
+TaskMate.
+
+` + "```hcl" + `
+resource "taskmate_task" "example" {
+  title = "Rotate certificates"
+
+  checklist = [
+    { text = "Issue new certificates" },
+    { text = "Roll out to load balancers" },
+    { text = "Revoke old certificates" },
+  ]
+}
+` + "```" + `
+
+## Assignees and Watchers
+
+` + "`assignee`" + ` and ` + "`watchers`" + ` take TaskMate usernames, and the plan fails if one of
+them does not exist. Use the ` + "`taskmate_user`" + ` data source to look users up by email.
+Leave ` + "`watchers`" + ` out to let people watch the task in TaskMate without causing a
+diff.
+
+` + "```hcl" + `
+data "taskmate_user" "oncall" {
+  email = "oncall@example.com"
+}
+
+resource "taskmate_task" "example" {
+  title    = "Rotate certificates"
+  assignee = data.taskmate_user.oncall.username
+  watchers = ["alice", "bob"]
+}
+` + "```" + `
+
+## Recurring Tasks
+
+Set ` + "`recurrence`" + ` to an RFC 5545 recurrence rule to repeat the task from its
+` + "`due_date`" + `. The rule is checked at plan time, and ` + "`next_occurrences`" + ` lists the
+next five due dates. Rules with a time of day, and the ` + "`BYYEARDAY`" + `, ` + "`BYWEEKNO`" + ` and
+` + "`BYSETPOS`" + ` parts, are not supported.
+
+Once the task is completed it moves on to its next due date. With the default
+` + "`recurrence_mode = \"server\"`" + ` the rule is sent to TaskMate, which does this
+itself. Servers without native recurrence support ignore the rule; set
+` + "`recurrence_mode = \"provider\"`" + ` there, and the provider reopens a completed task
+with its next due date on refresh. Either way ` + "`due_date`" + ` keeps holding the
+start of the series, so moving on does not cause a diff.
+
+` + "```hcl" + `
+resource "taskmate_task" "cert_review" {
+  title      = "Review certificate expiry"
+  due_date   = "2025-01-06"
+  recurrence = "FREQ=MONTHLY;BYDAY=1MO"
+}
+` + "```" + `
+
+## Workflows
+
+By default a task is either ` + "`pending`" + ` or ` + "`completed`" + `. Set ` + "`workflow_id`" + ` to a
+` + "`taskmate_workflow`" + ` to use its statuses instead. Changing ` + "`status`" + ` is then checked
+at plan time: the new status must be one of the workflow's and, for an existing
+task, reachable from its current one. The error lists the permitted next
+statuses.
+
+Completing or archiving the task on destroy is checked against the workflow
+too, and fails with the same error if the workflow does not allow it. A
+recurring task rolled forward by the provider counts as done in any of the
+workflow's final statuses, and reopens in its ` + "`initial_status`" + `.
+
+` + "```hcl" + `
+resource "taskmate_task" "deploy" {
+  title       = "Deploy"
+  workflow_id = taskmate_workflow.delivery.id
+  status      = "in_review"
+}
+` + "```" + `
+
+## Deletion Policy
+
+By default ` + "`terraform destroy`" + ` deletes the task. Set ` + "`deletion_policy`" + ` to keep it
+in TaskMate instead:
+
+- ` + "`complete`" + ` sets the task status to ` + "`completed`" + `
+- ` + "`archive`" + ` sets the task status to ` + "`archived`" + `
+- ` + "`abandon`" + ` only removes the task from Terraform state
+
+## Timeouts
+
+Each operation runs under a deadline that covers every API call it makes,
+including follow-up reads. The defaults are 20 minutes for create, update and
+delete and 5 minutes for read.
+
+` + "```hcl" + `
+resource "taskmate_task" "example" {
+  title = "Deploy application"
+
+  timeouts {
+    create = "5m"
+    delete = "2m"
+  }
+}
+` + "```" + `
 `,
 
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Task identifier",
+				MarkdownDescription: "Task identifier. Kept for compatibility; prefer `task_id`",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"task_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Numeric task identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"title": schema.StringAttribute{
 				MarkdownDescription: "Task title",
 				Required:            true,
@@ -93,25 +333,185 @@ See the examples/import directory for detailed import workflows.
 			"due_date": schema.StringAttribute{
 				MarkdownDescription: "Task due date (YYYY-MM-DD)",
 				Optional:            true,
+				Validators: []validator.String{
+					dueDateValidator{},
+				},
 			},
 			"priority": schema.StringAttribute{
 				MarkdownDescription: "Task priority (low, medium, high)",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "Task status (pending, completed)",
+				MarkdownDescription: "Task status. Without a workflow: pending, completed; with one, any of the workflow's statuses",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Creation timestamp",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
 				MarkdownDescription: "Last update timestamp",
 				Computed:            true,
 			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "Caller-chosen stable key stored on the task. Used to tell otherwise identical tasks apart when creating and adopting them. Changing this forces a new task",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "Labels set on the task by this resource. The provider's `default_labels` are added on top; see `effective_labels`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"effective_labels": schema.SetAttribute{
+				MarkdownDescription: "All labels on the task, including those from the provider's `default_labels`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the project the task belongs to. Changing this moves the task to the new project; removing it takes the task out of its project",
+				Optional:            true,
+			},
+			"checklist": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered checklist items. Left unmanaged when not set",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Checklist item identifier",
+							Computed:            true,
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "Checklist item text",
+							Required:            true,
+						},
+						"done": schema.BoolAttribute{
+							MarkdownDescription: "Whether the item is done. Left to TaskMate when not set",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"checklist_progress": schema.Int64Attribute{
+				MarkdownDescription: "Percentage of checklist items that are done, rounded down. 0 when the checklist is empty",
+				Computed:            true,
+			},
+			"assignee": schema.StringAttribute{
+				MarkdownDescription: "Username of the user the task is assigned to. Removing it unassigns the task",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"watchers": schema.SetAttribute{
+				MarkdownDescription: "Usernames of the users watching the task. Left unmanaged when not set",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"recurrence": schema.StringAttribute{
+				MarkdownDescription: "RFC 5545 recurrence rule, such as `FREQ=WEEKLY;BYDAY=MO`, repeating the task from `due_date`. Requires `due_date`",
+				Optional:            true,
+				Validators: []validator.String{
+					recurrenceValidator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("due_date")),
+				},
+			},
+			"recurrence_mode": schema.StringAttribute{
+				MarkdownDescription: "Who moves a completed recurring task on to its next due date (server, provider). Defaults to `server`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(recurrenceModeServer),
+				Validators: []validator.String{
+					stringvalidator.OneOf(recurrenceModeServer, recurrenceModeProvider),
+				},
+			},
+			"next_occurrences": schema.ListAttribute{
+				MarkdownDescription: "The next five due dates of a recurring task, from today on",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"workflow_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the workflow whose statuses and transitions the task follows. Removing it returns the task to the default statuses",
+				Optional:            true,
+			},
+			"estimate_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Estimated effort in minutes. Left to TaskMate when not set",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"time_spent_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Time logged against the task in TaskMate, in minutes",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				MarkdownDescription: "When the task was last moved to `completed`. Null while it is not completed",
+				Computed:            true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing task with the same title (and `external_id`, if set) instead of creating a new one. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_policy": schema.StringAttribute{
+				MarkdownDescription: "What to do with the task on destroy (delete, complete, archive, abandon). Defaults to `delete`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(deletionPolicyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						deletionPolicyDelete,
+						deletionPolicyComplete,
+						deletionPolicyArchive,
+						deletionPolicyAbandon,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *TaskResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"host": identityschema.StringAttribute{
+				Description:       "TaskMate API host URL the task lives on. Defaults to the provider's host",
+				OptionalForImport: true,
+			},
+			"id": identityschema.Int64Attribute{
+				Description:       "Numeric task identifier",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -134,6 +534,308 @@ func (r *TaskResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ModifyPlan merges the provider's default labels into effective_labels,
+// matches checklist items to the existing ones, checks that assigned users
+// exist and that status changes follow the task's workflow, and keeps
+// updated_at and completed_at at their prior values unless the plan actually
+// changes the task or its status, so no-op plans stay clean.
+func (r *TaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TaskResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.EffectiveLabels = r.effectiveLabels(plan.Labels)
+
+	var state TaskResourceModel
+	state.Checklist = types.ListNull(checklistItemType)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var checklist types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("checklist"), &checklist)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Checklist = planChecklist(checklist, state.Checklist)
+	plan.ChecklistProgress = checklistProgress(plan.Checklist)
+
+	// Keep the refreshed occurrences unless the series itself changes.
+	if !req.State.Raw.IsNull() && plan.Recurrence.Equal(state.Recurrence) && plan.DueDate.Equal(state.DueDate) {
+		plan.NextOccurrences = state.NextOccurrences
+	} else {
+		plan.NextOccurrences = nextOccurrences(plan.Recurrence, plan.DueDate)
+	}
+
+	// completed_at only moves with the status.
+	if !req.State.Raw.IsNull() && plan.Status.Equal(state.Status) {
+		plan.CompletedAt = state.CompletedAt
+	} else {
+		plan.CompletedAt = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(r.checkUsers(ctx, plan, state)...)
+	resp.Diagnostics.Append(r.checkTransition(ctx, plan, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		if taskChanged(plan, state) {
+			plan.UpdatedAt = types.StringUnknown()
+		} else {
+			plan.UpdatedAt = state.UpdatedAt
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// effectiveLabels returns the configured labels merged with the provider's
+// default labels. It is unknown while any configured label is.
+func (r *TaskResource) effectiveLabels(labels types.Set) types.Set {
+	if labels.IsUnknown() {
+		return types.SetUnknown(types.StringType)
+	}
+	for _, label := range labels.Elements() {
+		if label.IsUnknown() {
+			return types.SetUnknown(types.StringType)
+		}
+	}
+
+	var defaults []string
+	if r.client != nil {
+		defaults = r.client.DefaultLabels
+	}
+
+	return labelSet(mergeLabels(setLabels(labels), defaults))
+}
+
+// checkUsers returns an error for each planned assignee or watcher that is
+// not a TaskMate user. Only users the plan adds are checked, so a user removed
+// from TaskMate does not block unrelated changes.
+func (r *TaskResource) checkUsers(ctx context.Context, plan, state TaskResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	type userRef struct {
+		path     path.Path
+		username string
+	}
+	var refs []userRef
+
+	if !plan.Assignee.IsNull() && !plan.Assignee.IsUnknown() && !plan.Assignee.Equal(state.Assignee) {
+		refs = append(refs, userRef{path.Root("assignee"), plan.Assignee.ValueString()})
+	}
+
+	prior := setLabels(state.Watchers)
+	for _, watcher := range setLabels(plan.Watchers) {
+		if !slices.Contains(prior, watcher) {
+			refs = append(refs, userRef{path.Root("watchers").AtSetValue(types.StringValue(watcher)), watcher})
+		}
+	}
+
+	// The provider is not configured yet, e.g. during validation.
+	if len(refs) == 0 || r.client == nil {
+		return diags
+	}
+
+	users, err := r.client.ListUsers(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return diags
+	}
+
+	for _, ref := range refs {
+		if userByUsername(users, ref.username) == nil {
+			diags.AddAttributeError(
+				ref.path,
+				"Unknown User",
+				fmt.Sprintf("No TaskMate user has the username %q. Use the taskmate_user data source to look users up by email.", ref.username),
+			)
+		}
+	}
+
+	return diags
+}
+
+// checkTransition returns an error if the planned status is not one of the
+// workflow's statuses or, for an existing task, cannot be reached from its
+// current status. New tasks, and tasks whose current status is not part of
+// the workflow, may take any of the workflow's statuses.
+func (r *TaskResource) checkTransition(ctx context.Context, plan, state TaskResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.WorkflowID.IsNull() || plan.WorkflowID.IsUnknown() || plan.Status.IsNull() || plan.Status.IsUnknown() {
+		return diags
+	}
+
+	// Nothing changes for a task that keeps both its workflow and status.
+	if plan.WorkflowID.Equal(state.WorkflowID) && plan.Status.Equal(state.Status) {
+		return diags
+	}
+
+	// The provider is not configured yet, e.g. during validation.
+	if r.client == nil {
+		return diags
+	}
+
+	workflow, err := r.client.GetWorkflow(ctx, int(plan.WorkflowID.ValueInt64()))
+	if err != nil {
+		diags.AddAttributeError(path.Root("workflow_id"), "Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return diags
+	}
+
+	status := plan.Status.ValueString()
+	if !slices.Contains(workflow.Statuses, status) {
+		diags.AddAttributeError(
+			path.Root("status"),
+			"Unknown Workflow Status",
+			fmt.Sprintf("%q is not a status of workflow %q. Its statuses are: %s.", status, workflow.Name, formatStatuses(workflow.Statuses)),
+		)
+		return diags
+	}
+
+	current := state.Status.ValueString()
+	if state.Status.IsNull() || current == status || !slices.Contains(workflow.Statuses, current) {
+		return diags
+	}
+
+	next := nextStatuses(workflow, current)
+	if slices.Contains(next, status) {
+		return diags
+	}
+
+	if len(next) == 0 {
+		diags.AddAttributeError(
+			path.Root("status"),
+			"Invalid Status Transition",
+			fmt.Sprintf("Workflow %q does not allow moving a task from %q to %q: %q is a final status.", workflow.Name, current, status, current),
+		)
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("status"),
+		"Invalid Status Transition",
+		fmt.Sprintf("Workflow %q does not allow moving a task from %q to %q. Permitted next statuses: %s.", workflow.Name, current, status, formatStatuses(next)),
+	)
+
+	return diags
+}
+
+// watchersChanged reports whether the planned watchers need sending. A null
+// plan leaves watchers unmanaged.
+func watchersChanged(plan, state types.Set) bool {
+	return !plan.IsNull() && !plan.Equal(state)
+}
+
+// taskChanged reports whether any API-backed field differs between the
+// planned and prior state.
+func taskChanged(plan, state TaskResourceModel) bool {
+	return !plan.Title.Equal(state.Title) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.DueDate.Equal(state.DueDate) ||
+		!plan.Priority.Equal(state.Priority) ||
+		!plan.Status.Equal(state.Status) ||
+		!plan.EffectiveLabels.Equal(state.EffectiveLabels) ||
+		!plan.ProjectID.Equal(state.ProjectID) ||
+		!plan.WorkflowID.Equal(state.WorkflowID) ||
+		!plan.EstimateMinutes.Equal(state.EstimateMinutes) ||
+		!plan.Checklist.Equal(state.Checklist) ||
+		!plan.Assignee.Equal(state.Assignee) ||
+		watchersChanged(plan.Watchers, state.Watchers) ||
+		!sentRecurrence(plan).Equal(sentRecurrence(state))
+}
+
+// sentRecurrence returns the recurrence rule TaskMate should know about. In
+// provider mode the server is not told about the rule.
+func sentRecurrence(data TaskResourceModel) types.String {
+	if data.RecurrenceMode.ValueString() == recurrenceModeProvider {
+		return types.StringNull()
+	}
+	return data.Recurrence
+}
+
+// taskPatch builds a partial update holding only the fields that differ
+// between plan and state. It reports false when nothing needs sending.
+func taskPatch(plan, state TaskResourceModel) (TaskPatch, bool) {
+	var patch TaskPatch
+	changed := false
+
+	set := func(dst **string, planned, prior types.String) {
+		if planned.IsUnknown() || planned.Equal(prior) {
+			return
+		}
+		value := planned.ValueString()
+		*dst = &value
+		changed = true
+	}
+
+	set(&patch.Title, plan.Title, state.Title)
+	set(&patch.Description, plan.Description, state.Description)
+	set(&patch.DueDate, plan.DueDate, state.DueDate)
+	set(&patch.Priority, plan.Priority, state.Priority)
+	set(&patch.Status, plan.Status, state.Status)
+	set(&patch.Assignee, plan.Assignee, state.Assignee)
+	set(&patch.Recurrence, sentRecurrence(plan), sentRecurrence(state))
+
+	if !plan.EffectiveLabels.IsUnknown() && !plan.EffectiveLabels.Equal(state.EffectiveLabels) {
+		labels := setLabels(plan.EffectiveLabels)
+		patch.Labels = &labels
+		changed = true
+	}
+
+	if !plan.ProjectID.IsUnknown() && !plan.ProjectID.Equal(state.ProjectID) {
+		projectID := int(plan.ProjectID.ValueInt64())
+		patch.ProjectID = &projectID
+		changed = true
+	}
+
+	if !plan.WorkflowID.IsUnknown() && !plan.WorkflowID.Equal(state.WorkflowID) {
+		workflowID := int(plan.WorkflowID.ValueInt64())
+		patch.WorkflowID = &workflowID
+		changed = true
+	}
+
+	if !plan.EstimateMinutes.IsUnknown() && !plan.EstimateMinutes.Equal(state.EstimateMinutes) {
+		estimate := int(plan.EstimateMinutes.ValueInt64())
+		patch.EstimateMinutes = &estimate
+		changed = true
+	}
+
+	if !plan.Checklist.IsUnknown() && !plan.Checklist.Equal(state.Checklist) {
+		checklist := checklistItems(plan.Checklist)
+		patch.Checklist = &checklist
+		changed = true
+	}
+
+	if !plan.Watchers.IsUnknown() && watchersChanged(plan.Watchers, state.Watchers) {
+		watchers := setLabels(plan.Watchers)
+		patch.Watchers = &watchers
+		changed = true
+	}
+
+	return patch, changed
+}
+
 func (r *TaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TaskResourceModel
 
@@ -143,27 +845,256 @@ func (r *TaskResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	task, err := r.client.CreateTask(
-		data.Title.ValueString(),
-		data.Description.ValueString(),
-		data.DueDate.ValueString(),
-		data.Priority.ValueString(),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create task, got error: %s", err))
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.ID = types.StringValue(fmt.Sprintf("%d", task.ID))
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var task *Task
+	var err error
+
+	if data.AdoptExisting.ValueBool() {
+		task, err = r.findAdoptable(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up task to adopt, got error: %s", err))
+			return
+		}
+	}
+
+	if task != nil {
+		task, err = r.adopt(ctx, task, data)
+		if err != nil {
+			addWriteError(&resp.Diagnostics, "adopt", err)
+			return
+		}
+	} else {
+		task, err = r.client.CreateTask(ctx, TaskInput{
+			Title:           data.Title.ValueString(),
+			Description:     data.Description.ValueString(),
+			DueDate:         data.DueDate.ValueString(),
+			Priority:        data.Priority.ValueString(),
+			ExternalID:      data.ExternalID.ValueString(),
+			Labels:          setLabels(data.EffectiveLabels),
+			ProjectID:       int(data.ProjectID.ValueInt64()),
+			Checklist:       checklistItems(data.Checklist),
+			Assignee:        data.Assignee.ValueString(),
+			Watchers:        setLabels(data.Watchers),
+			Recurrence:      sentRecurrence(data).ValueString(),
+			WorkflowID:      int(data.WorkflowID.ValueInt64()),
+			EstimateMinutes: int(data.EstimateMinutes.ValueInt64()),
+		})
+		if errors.Is(err, ErrTaskExists) && data.AdoptExisting.ValueBool() {
+			// Someone created a matching task since we looked; adopt it.
+			var existing *Task
+			existing, err = r.findAdoptable(ctx, data)
+			if err == nil && existing == nil {
+				err = fmt.Errorf("task %q: %w", data.Title.ValueString(), ErrTaskExists)
+			}
+			if err == nil {
+				task, err = r.adopt(ctx, existing, data)
+			}
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create task, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(setTaskVersion(ctx, resp.Private, task)...)
+
+	setTaskResourceModel(&data, task)
+	if data.NextOccurrences.IsUnknown() {
+		data.NextOccurrences = nextOccurrences(data.Recurrence, data.DueDate)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(task.ID))...)
+}
+
+// setTaskResourceModel copies task into data. Values that depend on what
+// Terraform manages, such as external_id, watchers and a recurring due date,
+// are read from data, so set those first.
+func setTaskResourceModel(data *TaskResourceModel, task *Task) {
+	data.ID = types.StringValue(strconv.Itoa(task.ID))
+	data.TaskID = types.Int64Value(int64(task.ID))
 	data.Title = types.StringValue(task.Title)
 	data.Description = types.StringValue(task.Description)
-	data.DueDate = types.StringValue(task.DueDate)
+	data.DueDate = recurringDueDate(data.DueDate, data.Recurrence, task.DueDate)
 	data.Priority = types.StringValue(task.Priority)
 	data.Status = types.StringValue(task.Status)
+	if task.ExternalID != "" || !data.ExternalID.IsNull() {
+		data.ExternalID = types.StringValue(task.ExternalID)
+	}
+	data.EffectiveLabels = labelSet(mergeLabels(task.Labels))
+	data.ProjectID = taskProjectID(task)
+	data.WorkflowID = taskWorkflowID(task)
+	data.EstimateMinutes = taskEstimate(task)
+	data.TimeSpentMinutes = types.Int64Value(int64(task.TimeSpentMinutes))
+	data.CompletedAt = taskCompletedAt(task)
+	data.Checklist = checklistValue(task.Checklist)
+	data.ChecklistProgress = checklistProgress(data.Checklist)
+	data.Assignee = taskAssignee(task)
+	data.Watchers = taskWatchers(task, data.Watchers)
 	data.CreatedAt = types.StringValue(task.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	data.UpdatedAt = types.StringValue(task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+// taskProjectID returns the task's project ID, or null if it is in no
+// project.
+func taskProjectID(task *Task) types.Int64 {
+	if task.ProjectID == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(task.ProjectID))
+}
+
+// taskWorkflowID returns the task's workflow ID, or null if it follows the
+// default statuses.
+func taskWorkflowID(task *Task) types.Int64 {
+	if task.WorkflowID == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(task.WorkflowID))
+}
+
+// taskEstimate returns the task's estimate in minutes, or null if it has
+// none.
+func taskEstimate(task *Task) types.Int64 {
+	if task.EstimateMinutes == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(task.EstimateMinutes))
+}
+
+// taskCompletedAt returns when the task was completed, or null if it is not.
+func taskCompletedAt(task *Task) types.String {
+	if task.CompletedAt == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(task.CompletedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
+
+// taskAssignee returns the task's assignee, or null if it is unassigned.
+func taskAssignee(task *Task) types.String {
+	if task.Assignee == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(task.Assignee)
+}
+
+// taskWatchers returns the task's watchers, or null while prior is null and
+// watchers are not managed.
+func taskWatchers(task *Task, prior types.Set) types.Set {
+	if prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	return labelSet(mergeLabels(task.Watchers))
+}
+
+// rollForward reopens a finished recurring task with its next due date: the
+// first occurrence after both its current due date and yesterday, so a task
+// completed late skips the occurrences it missed. A task is finished when
+// "completed", or in one of its workflow's final statuses, and reopens as
+// "pending", or in the workflow's initial status. A task that is not finished,
+// or whose series has ended, is returned unchanged.
+func (r *TaskResource) rollForward(ctx context.Context, task *Task, data TaskResourceModel) (*Task, error) {
+	if data.Recurrence.IsNull() || data.DueDate.IsNull() {
+		return task, nil
+	}
+
+	finished, reopened := []string{"completed"}, "pending"
+	if task.WorkflowID != 0 {
+		workflow, err := r.client.GetWorkflow(ctx, task.WorkflowID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read workflow: %w", err)
+		}
+		finished, reopened = finalStatuses(workflow), initialStatus(workflow)
+	}
+	if !slices.Contains(finished, task.Status) {
+		return task, nil
+	}
+
+	rule, err := parseRecurrence(data.Recurrence.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := parseDueDate(data.DueDate.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	after := today().AddDate(0, 0, -1)
+	if current, err := parseDueDate(task.DueDate); err == nil && current.After(after) {
+		after = current
+	}
+
+	next := rule.after(start, after, 1)
+	if len(next) == 0 {
+		return task, nil
+	}
+
+	dueDate := next[0].Format(dueDateLayout)
+
+	return r.client.PatchTask(ctx, task.ID, task.ETag, TaskPatch{DueDate: &dueDate, Status: &reopened})
+}
+
+// findAdoptable returns the existing task with the planned title and, when
+// set, external_id. It returns nil if there is none and an error if the
+// match is ambiguous.
+func (r *TaskResource) findAdoptable(ctx context.Context, data TaskResourceModel) (*Task, error) {
+	tasks, err := r.client.ListTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*Task
+	for _, task := range tasks {
+		if task.Title != data.Title.ValueString() {
+			continue
+		}
+		if !data.ExternalID.IsNull() && task.ExternalID != data.ExternalID.ValueString() {
+			continue
+		}
+		if !data.ProjectID.IsNull() && int64(task.ProjectID) != data.ProjectID.ValueInt64() {
+			continue
+		}
+		matches = append(matches, task)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d tasks titled %q; set external_id to pick one", len(matches), data.Title.ValueString())
+	}
+}
+
+// adopt brings an existing task in line with the planned configuration.
+func (r *TaskResource) adopt(ctx context.Context, task *Task, data TaskResourceModel) (*Task, error) {
+	// Watchers are compared even when the plan leaves them unmanaged.
+	existing := TaskResourceModel{
+		Watchers:   labelSet(nil),
+		Recurrence: types.StringNull(),
+	}
+	if task.Recurrence != "" {
+		existing.Recurrence = types.StringValue(task.Recurrence)
+	}
+	setTaskResourceModel(&existing, task)
+
+	patch, changed := taskPatch(data, existing)
+	if !changed {
+		return task, nil
+	}
+
+	return r.client.PatchTask(ctx, task.ID, task.ETag, patch)
 }
 
 func (r *TaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -175,68 +1106,148 @@ func (r *TaskResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	var id int
-	_, err := fmt.Sscanf(data.ID.ValueString(), "%d", &id)
-	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse task ID: %s", err))
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	task, err := r.client.GetTask(id)
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	id := int(data.TaskID.ValueInt64())
+
+	resp.Diagnostics.Append(r.validateIdentity(ctx, req.Identity, id)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetTask(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read task, got error: %s", err))
 		return
 	}
 
-	data.Title = types.StringValue(task.Title)
-	data.Description = types.StringValue(task.Description)
-	data.DueDate = types.StringValue(task.DueDate)
-	data.Priority = types.StringValue(task.Priority)
-	data.Status = types.StringValue(task.Status)
-	data.CreatedAt = types.StringValue(task.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	if task.ID != id {
+		resp.Diagnostics.AddError(
+			"Unexpected Task Returned",
+			fmt.Sprintf("Requested task %d but the API returned task %d. Please report this issue to the TaskMate server maintainers.", id, task.ID),
+		)
+		return
+	}
+
+	if data.RecurrenceMode.ValueString() == recurrenceModeProvider {
+		task, err = r.rollForward(ctx, task, data)
+		if err != nil {
+			addWriteError(&resp.Diagnostics, "roll forward recurring", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(setTaskVersion(ctx, resp.Private, task)...)
+
+	// Servers without native recurrence support do not report the rule;
+	// keep the configured one then.
+	if task.Recurrence != "" && data.RecurrenceMode.ValueString() != recurrenceModeProvider {
+		data.Recurrence = types.StringValue(task.Recurrence)
+	}
+
+	setTaskResourceModel(&data, task)
+	data.NextOccurrences = nextOccurrences(data.Recurrence, data.DueDate)
+	// Keep only the configured labels still on the task, so removing one in
+	// TaskMate shows up as a diff on labels. Labels added outside Terraform
+	// show up as a diff on effective_labels instead.
+	if !data.Labels.IsNull() {
+		data.Labels = labelSet(keepLabels(setLabels(data.Labels), task.Labels))
+	}
+
+	// Imported tasks have no Terraform-side settings yet; fall back to the
+	// defaults.
+	if data.AdoptExisting.IsNull() {
+		data.AdoptExisting = types.BoolValue(false)
+	}
+	if data.DeletionPolicy.IsNull() {
+		data.DeletionPolicy = types.StringValue(deletionPolicyDelete)
+	}
+	if data.RecurrenceMode.IsNull() {
+		data.RecurrenceMode = types.StringValue(recurrenceModeServer)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(task.ID))...)
 }
 
 func (r *TaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data TaskResourceModel
+	var data, state TaskResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var id int
-	_, err := fmt.Sscanf(data.ID.ValueString(), "%d", &id)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := int(data.TaskID.ValueInt64())
+
+	patch, changed := taskPatch(data, state)
+	if !changed {
+		// Only Terraform-side settings such as deletion_policy or timeouts changed.
+		// Nothing was sent, so server-side values stay as they were.
+		data.UpdatedAt = state.UpdatedAt
+		data.TimeSpentMinutes = state.TimeSpentMinutes
+		data.CompletedAt = state.CompletedAt
+		if data.Checklist.IsUnknown() {
+			data.Checklist = state.Checklist
+			data.ChecklistProgress = state.ChecklistProgress
+		}
+		if data.NextOccurrences.IsUnknown() {
+			data.NextOccurrences = nextOccurrences(data.Recurrence, data.DueDate)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(id))...)
+		return
+	}
+
+	version, diags := getTaskVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, err := r.ifMatch(ctx, id, version)
 	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse task ID: %s", err))
+		addWriteError(&resp.Diagnostics, "update", err)
 		return
 	}
 
-	task, err := r.client.UpdateTask(
-		id,
-		data.Title.ValueString(),
-		data.Description.ValueString(),
-		data.DueDate.ValueString(),
-		data.Priority.ValueString(),
-		data.Status.ValueString(),
-	)
+	task, err := r.client.PatchTask(ctx, id, ifMatch, patch)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update task, got error: %s", err))
+		addWriteError(&resp.Diagnostics, "update", err)
 		return
 	}
 
-	data.Title = types.StringValue(task.Title)
-	data.Description = types.StringValue(task.Description)
-	data.DueDate = types.StringValue(task.DueDate)
-	data.Priority = types.StringValue(task.Priority)
-	data.Status = types.StringValue(task.Status)
-	data.CreatedAt = types.StringValue(task.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	resp.Diagnostics.Append(setTaskVersion(ctx, resp.Private, task)...)
+
+	setTaskResourceModel(&data, task)
+	if data.NextOccurrences.IsUnknown() {
+		data.NextOccurrences = nextOccurrences(data.Recurrence, data.DueDate)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(task.ID))...)
 }
 
 func (r *TaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -248,20 +1259,265 @@ func (r *TaskResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	var id int
-	_, err := fmt.Sscanf(data.ID.ValueString(), "%d", &id)
-	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse task ID: %s", err))
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err = r.client.DeleteTask(id)
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if data.DeletionPolicy.ValueString() == deletionPolicyAbandon {
+		return
+	}
+
+	id := int(data.TaskID.ValueInt64())
+
+	version, diags := getTaskVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifMatch, err := r.ifMatch(ctx, id, version)
+	if err == nil {
+		switch data.DeletionPolicy.ValueString() {
+		case deletionPolicyComplete:
+			err = r.setStatus(ctx, id, ifMatch, data, "completed")
+		case deletionPolicyArchive:
+			err = r.setStatus(ctx, id, ifMatch, data, "archived")
+		default:
+			err = r.client.DeleteTask(ctx, id, ifMatch)
+		}
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete task, got error: %s", err))
+		addWriteError(&resp.Diagnostics, "delete", err)
 		return
 	}
 }
 
+// setStatus moves a task to the given status without touching its other
+// fields, after checking that the task's workflow allows it.
+func (r *TaskResource) setStatus(ctx context.Context, id int, ifMatch string, data TaskResourceModel, status string) error {
+	workflowID := 0
+	if !data.WorkflowID.IsNull() {
+		workflowID = int(data.WorkflowID.ValueInt64())
+	}
+	if err := r.checkStatus(ctx, workflowID, data.Status.ValueString(), status); err != nil {
+		return err
+	}
+
+	_, err := r.client.PatchTask(ctx, id, ifMatch, TaskPatch{Status: &status})
+	return err
+}
+
+// checkStatus returns an error if the workflow with ID workflowID does not let
+// a task move from current to status. Tasks in no workflow may take any
+// status.
+func (r *TaskResource) checkStatus(ctx context.Context, workflowID int, current, status string) error {
+	if workflowID == 0 {
+		return nil
+	}
+
+	workflow, err := r.client.GetWorkflow(ctx, workflowID)
+	if err != nil {
+		return fmt.Errorf("unable to read workflow: %w", err)
+	}
+
+	return checkWorkflowStatus(workflow, current, status)
+}
+
 func (r *TaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID != "" {
+		id, err := r.resolveImportID(ctx, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Unable to resolve %q to a task: %s\n\n"+
+					"Expected a numeric task ID, <host>/<id>, title:<exact title> or external_id:<key>.", req.ID, err),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(id))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("task_id"), int64(id))...)
+		return
+	}
+
+	// Imported through an import block's identity attribute.
+	var identity TaskResourceIdentityModel
+
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !identity.Host.IsNull() && !sameHost(identity.Host.ValueString(), r.client.Host) {
+		resp.Diagnostics.AddError(
+			"Import Host Mismatch",
+			fmt.Sprintf("The import identity names host %q, but the provider is configured for %q.", identity.Host.ValueString(), r.client.Host),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(identity.ID.ValueInt64(), 10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("task_id"), identity.ID.ValueInt64())...)
+}
+
+// resolveImportID turns an import ID into a numeric task ID. It accepts a
+// plain ID, <host>/<id>, title:<exact title> and external_id:<key>.
+func (r *TaskResource) resolveImportID(ctx context.Context, importID string) (int, error) {
+	if title, ok := strings.CutPrefix(importID, "title:"); ok {
+		return r.findTaskID(ctx, "title", title, func(task *Task) bool { return task.Title == title })
+	}
+
+	if key, ok := strings.CutPrefix(importID, "external_id:"); ok {
+		return r.findTaskID(ctx, "external_id", key, func(task *Task) bool { return task.ExternalID == key })
+	}
+
+	idPart := importID
+	if i := strings.LastIndex(importID, "/"); i >= 0 {
+		host := importID[:i]
+		if !sameHost(host, r.client.Host) {
+			return 0, fmt.Errorf("host %q does not match the provider's host %q", host, r.client.Host)
+		}
+		idPart = importID[i+1:]
+	}
+
+	id, err := parseTaskID(idPart)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := r.client.GetTask(ctx, id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// findTaskID returns the ID of the single task matching match. field and
+// value are only used in error messages.
+func (r *TaskResource) findTaskID(ctx context.Context, field, value string, match func(*Task) bool) (int, error) {
+	if value == "" {
+		return 0, fmt.Errorf("%s must not be empty", field)
+	}
+
+	tasks, err := r.client.ListTasks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []string
+	var found int
+	for _, task := range tasks {
+		if match(task) {
+			found = task.ID
+			ids = append(ids, strconv.Itoa(task.ID))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("no task with %s %q", field, value)
+	case 1:
+		return found, nil
+	default:
+		return 0, fmt.Errorf("%d tasks have %s %q (IDs %s); import one of them by ID", len(ids), field, value, strings.Join(ids, ", "))
+	}
+}
+
+// parseTaskID parses a task ID, rejecting anything that is not a positive
+// integer.
+func parseTaskID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("task ID must be a positive integer, got %q", s)
+	}
+
+	return id, nil
+}
+
+// parseNestedImportID parses the <task_id>/<id> import ID of a resource that
+// lives under a task, such as a comment. kind names that resource in errors.
+func parseNestedImportID(importID, kind string) (int, int, error) {
+	taskPart, idPart, ok := strings.Cut(importID, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected <task_id>/<%s_id>, got %q", kind, importID)
+	}
+
+	taskID, err := parseTaskID(taskPart)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, err := strconv.Atoi(idPart)
+	if err != nil || id <= 0 {
+		return 0, 0, fmt.Errorf("%s ID must be a positive integer, got %q", kind, idPart)
+	}
+
+	return taskID, id, nil
+}
+
+// sameHost reports whether two host URLs refer to the same TaskMate host,
+// ignoring scheme and trailing slashes.
+func sameHost(a, b string) bool {
+	normalize := func(host string) string {
+		host = strings.TrimPrefix(host, "https://")
+		host = strings.TrimPrefix(host, "http://")
+		return strings.TrimRight(host, "/")
+	}
+
+	return normalize(a) == normalize(b)
+}
+
+// identity returns the resource identity for task id on the configured host.
+func (r *TaskResource) identity(id int) TaskResourceIdentityModel {
+	return TaskResourceIdentityModel{
+		Host: types.StringValue(r.client.Host),
+		ID:   types.Int64Value(int64(id)),
+	}
+}
+
+// validateIdentity checks that a previously stored identity still describes
+// task id on the configured host. State written before identity support has
+// no identity and is accepted as is.
+func (r *TaskResource) validateIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil || identity.Raw.IsNull() {
+		return diags
+	}
+
+	var data TaskResourceIdentityModel
+
+	diags.Append(identity.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.Host.IsNull() && !sameHost(data.Host.ValueString(), r.client.Host) {
+		diags.AddError(
+			"Resource Identity Mismatch",
+			fmt.Sprintf("This task was recorded on host %q, but the provider is configured for %q. "+
+				"Point the provider back at the original host, or remove the task from state and import it again.",
+				data.Host.ValueString(), r.client.Host),
+		)
+	}
+
+	if !data.ID.IsNull() && data.ID.ValueInt64() != int64(id) {
+		diags.AddError(
+			"Resource Identity Mismatch",
+			fmt.Sprintf("State holds task ID %d, but the resource identity records task %d. "+
+				"The task may have been swapped for another; remove it from state and import it again.",
+				id, data.ID.ValueInt64()),
+		)
+	}
+
+	return diags
 }
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTaskServer serves GET /tasks and GET /tasks/{id} of the v1 API from
+// tasks.
+func newTaskServer(t *testing.T, tasks []*Task) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+
+		route := strings.TrimPrefix(r.URL.Path, "/api/v1")
+		if route == "/tasks" {
+			_ = json.NewEncoder(w).Encode(tasks)
+			return
+		}
+
+		id, err := strconv.Atoi(strings.TrimPrefix(route, "/tasks/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		for _, task := range tasks {
+			if task.ID == id {
+				_ = json.NewEncoder(w).Encode(task)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestResolveImportID(t *testing.T) {
+	server := newTaskServer(t, []*Task{
+		{ID: 1, Title: "Deploy", ExternalID: "deploy-prod"},
+		{ID: 2, Title: "Review"},
+		{ID: 3, Title: "Review"},
+	})
+	host := strings.TrimPrefix(server.URL, "http://")
+	r := &TaskResource{client: NewClient(server.URL, "token")}
+
+	tests := []struct {
+		importID string
+		want     int
+		wantErr  string
+	}{
+		{importID: "2", want: 2},
+		{importID: server.URL + "/2", want: 2},
+		{importID: host + "/2", want: 2},
+		{importID: server.URL + "/2/", wantErr: "does not match"},
+		{importID: "https://other.example.com/2", wantErr: "does not match"},
+		{importID: "title:Deploy", want: 1},
+		{importID: "external_id:deploy-prod", want: 1},
+		{importID: "title:Review", wantErr: "2, 3"},
+		{importID: "title:Missing", wantErr: "no task"},
+		{importID: "title:", wantErr: "must not be empty"},
+		{importID: "0", wantErr: "positive integer"},
+		{importID: "abc", wantErr: "positive integer"},
+		{importID: "9", wantErr: "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			got, err := r.resolveImportID(context.Background(), tt.importID)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("resolveImportID returned error: %s", err)
+			case tt.wantErr == "" && got != tt.want:
+				t.Errorf("resolveImportID = %d, want %d", got, tt.want)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("resolveImportID = %d, want error containing %q", got, tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Errorf("resolveImportID error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetTaskResourceModel(t *testing.T) {
+	task := &Task{
+		ID:         4,
+		Title:      "Deploy",
+		DueDate:    "2026-10-21",
+		Status:     "pending",
+		Labels:     []string{"ops"},
+		Watchers:   []string{"bob", "alice"},
+		Recurrence: "FREQ=WEEKLY",
+	}
+
+	tests := []struct {
+		name           string
+		data           TaskResourceModel
+		wantDueDate    types.String
+		wantExternalID types.String
+		wantWatchers   types.Set
+	}{
+		{
+			name:           "unmanaged",
+			data:           TaskResourceModel{ExternalID: types.StringNull(), Watchers: types.SetNull(types.StringType)},
+			wantDueDate:    types.StringValue("2026-10-21"),
+			wantExternalID: types.StringNull(),
+			wantWatchers:   types.SetNull(types.StringType),
+		},
+		{
+			name:           "managed",
+			data:           TaskResourceModel{ExternalID: types.StringValue("deploy-1"), Watchers: labelSet(nil)},
+			wantDueDate:    types.StringValue("2026-10-21"),
+			wantExternalID: types.StringValue(""),
+			wantWatchers:   labelSet([]string{"alice", "bob"}),
+		},
+		{
+			name: "later occurrence keeps the series start",
+			data: TaskResourceModel{
+				DueDate:    types.StringValue("2026-10-14"),
+				Recurrence: types.StringValue("FREQ=WEEKLY"),
+				ExternalID: types.StringNull(),
+				Watchers:   types.SetNull(types.StringType),
+			},
+			wantDueDate:    types.StringValue("2026-10-14"),
+			wantExternalID: types.StringNull(),
+			wantWatchers:   types.SetNull(types.StringType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.data
+			setTaskResourceModel(&data, task)
+
+			if !data.ID.Equal(types.StringValue("4")) || !data.TaskID.Equal(types.Int64Value(4)) {
+				t.Errorf("id = %s, task_id = %s, want 4", data.ID, data.TaskID)
+			}
+			if !data.DueDate.Equal(tt.wantDueDate) {
+				t.Errorf("due_date = %s, want %s", data.DueDate, tt.wantDueDate)
+			}
+			if !data.ExternalID.Equal(tt.wantExternalID) {
+				t.Errorf("external_id = %s, want %s", data.ExternalID, tt.wantExternalID)
+			}
+			if !data.Watchers.Equal(tt.wantWatchers) {
+				t.Errorf("watchers = %s, want %s", data.Watchers, tt.wantWatchers)
+			}
+			if !data.EffectiveLabels.Equal(labelSet([]string{"ops"})) {
+				t.Errorf("effective_labels = %s, want [ops]", data.EffectiveLabels)
+			}
+		})
+	}
+}
+
+func TestRollForward(t *testing.T) {
+	tests := []struct {
+		name       string
+		task       Task
+		wantStatus string
+	}{
+		{name: "completed without a workflow", task: Task{ID: 3, Status: "completed"}, wantStatus: "pending"},
+		{name: "pending without a workflow", task: Task{ID: 3, Status: "pending"}},
+		// delivery has no "pending" status; "done" and "archived" are final.
+		{name: "final workflow status", task: Task{ID: 3, Status: "done", WorkflowID: 1}, wantStatus: "todo"},
+		{name: "other final workflow status", task: Task{ID: 3, Status: "archived", WorkflowID: 1}, wantStatus: "todo"},
+		{name: "open workflow status", task: Task{ID: 3, Status: "review", WorkflowID: 1}},
+		{name: "completed is not final in the workflow", task: Task{ID: 3, Status: "completed", WorkflowID: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch *TaskPatch
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch route := strings.TrimPrefix(r.URL.Path, "/api/v1"); {
+				case r.Method == http.MethodGet && route == "/workflows/1":
+					_ = json.NewEncoder(w).Encode(delivery)
+				case r.Method == http.MethodPatch && route == "/tasks/3":
+					patch = &TaskPatch{}
+					_ = json.NewDecoder(r.Body).Decode(patch)
+					_ = json.NewEncoder(w).Encode(Task{ID: 3, Status: *patch.Status, DueDate: *patch.DueDate})
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			r := &TaskResource{client: NewClient(server.URL, "token")}
+			data := TaskResourceModel{
+				DueDate:    types.StringValue("2020-01-01"),
+				Recurrence: types.StringValue("FREQ=DAILY"),
+			}
+			task := tt.task
+			task.DueDate = "2020-01-01"
+
+			got, err := r.rollForward(context.Background(), &task, data)
+			if err != nil {
+				t.Fatalf("rollForward returned error: %s", err)
+			}
+
+			if tt.wantStatus == "" {
+				if patch != nil || got.Status != tt.task.Status {
+					t.Errorf("rollForward reopened the task as %q, want it unchanged", got.Status)
+				}
+				return
+			}
+			if patch == nil {
+				t.Fatal("rollForward did not reopen the task")
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got.Status, tt.wantStatus)
+			}
+			if want := today().Format(dueDateLayout); got.DueDate != want {
+				t.Errorf("due date = %q, want %q", got.DueDate, want)
+			}
+		})
+	}
+}
+
+func TestParseNestedImportID(t *testing.T) {
+	tests := []struct {
+		importID   string
+		wantTaskID int
+		wantID     int
+		wantErr    string
+	}{
+		{importID: "1/3", wantTaskID: 1, wantID: 3},
+		{importID: "1", wantErr: "expected <task_id>/<comment_id>"},
+		{importID: "0/3", wantErr: "task ID must be a positive integer"},
+		{importID: "1/0", wantErr: `comment ID must be a positive integer, got "0"`},
+		{importID: "1/abc", wantErr: "comment ID must be"},
+		{importID: "1/3/", wantErr: "comment ID must be"},
+		{importID: "taskmate.example.com/1/3", wantErr: "task ID must be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			taskID, id, err := parseNestedImportID(tt.importID, "comment")
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("parseNestedImportID returned error: %s", err)
+			case tt.wantErr == "" && (taskID != tt.wantTaskID || id != tt.wantID):
+				t.Errorf("parseNestedImportID = %d, %d, want %d, %d", taskID, id, tt.wantTaskID, tt.wantID)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("parseNestedImportID = %d, %d, want error containing %q", taskID, id, tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Errorf("parseNestedImportID error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
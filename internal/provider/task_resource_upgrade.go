@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TaskResourceModelV0 describes the version 0 resource data model, which
+// only carried the task ID as a string.
+type TaskResourceModelV0 struct {
+	ID             types.String   `tfsdk:"id"`
+	Title          types.String   `tfsdk:"title"`
+	Description    types.String   `tfsdk:"description"`
+	DueDate        types.String   `tfsdk:"due_date"`
+	Priority       types.String   `tfsdk:"priority"`
+	Status         types.String   `tfsdk:"status"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+	UpdatedAt      types.String   `tfsdk:"updated_at"`
+	ExternalID     types.String   `tfsdk:"external_id"`
+	AdoptExisting  types.Bool     `tfsdk:"adopt_existing"`
+	DeletionPolicy types.String   `tfsdk:"deletion_policy"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TaskResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":              schema.StringAttribute{Computed: true},
+					"title":           schema.StringAttribute{Required: true},
+					"description":     schema.StringAttribute{Optional: true},
+					"due_date":        schema.StringAttribute{Optional: true},
+					"priority":        schema.StringAttribute{Optional: true, Computed: true},
+					"status":          schema.StringAttribute{Optional: true, Computed: true},
+					"created_at":      schema.StringAttribute{Computed: true},
+					"updated_at":      schema.StringAttribute{Computed: true},
+					"external_id":     schema.StringAttribute{Optional: true},
+					"adopt_existing":  schema.BoolAttribute{Optional: true, Computed: true},
+					"deletion_policy": schema.StringAttribute{Optional: true, Computed: true},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Create: true,
+						Read:   true,
+						Update: true,
+						Delete: true,
+					}),
+				},
+			},
+			StateUpgrader: upgradeTaskStateV0,
+		},
+	}
+}
+
+// upgradeTaskStateV0 adds the numeric task_id, parsed from the old string ID.
+func upgradeTaskStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior TaskResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseTaskID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource State",
+			fmt.Sprintf("The stored task ID could not be parsed: %s. Remove the task from state and import it again.", err),
+		)
+		return
+	}
+
+	data := TaskResourceModel{
+		ID:                prior.ID,
+		TaskID:            types.Int64Value(int64(id)),
+		Title:             prior.Title,
+		Description:       prior.Description,
+		DueDate:           prior.DueDate,
+		Priority:          prior.Priority,
+		Status:            prior.Status,
+		CreatedAt:         prior.CreatedAt,
+		UpdatedAt:         prior.UpdatedAt,
+		ExternalID:        prior.ExternalID,
+		AdoptExisting:     prior.AdoptExisting,
+		DeletionPolicy:    prior.DeletionPolicy,
+		Labels:            types.SetNull(types.StringType),
+		EffectiveLabels:   types.SetNull(types.StringType),
+		ProjectID:         types.Int64Null(),
+		Checklist:         types.ListNull(checklistItemType),
+		ChecklistProgress: types.Int64Null(),
+		Assignee:          types.StringNull(),
+		Watchers:          types.SetNull(types.StringType),
+		Recurrence:        types.StringNull(),
+		RecurrenceMode:    types.StringValue(recurrenceModeServer),
+		NextOccurrences:   types.ListNull(types.StringType),
+		WorkflowID:        types.Int64Null(),
+		EstimateMinutes:   types.Int64Null(),
+		TimeSpentMinutes:  types.Int64Null(),
+		CompletedAt:       types.StringNull(),
+		Timeouts:          prior.Timeouts,
+	}
+
+	// Attributes added after the original release are missing from older
+	// state; fill in their defaults.
+	if data.AdoptExisting.IsNull() {
+		data.AdoptExisting = types.BoolValue(false)
+	}
+	if data.DeletionPolicy.IsNull() {
+		data.DeletionPolicy = types.StringValue(deletionPolicyDelete)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// privateKeyTaskVersion is the private state key holding the task version
+// Terraform last saw.
+const privateKeyTaskVersion = "task_version"
+
+// privateStateGetter and privateStateSetter are satisfied by the Private
+// fields of the framework's resource requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// taskVersion identifies the revision of a task Terraform last read. ETag is
+// preferred; UpdatedAt is the fallback for servers that do not send one.
+type taskVersion struct {
+	ETag      string `json:"etag,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+func newTaskVersion(task *Task) taskVersion {
+	return taskVersion{
+		ETag:      task.ETag,
+		UpdatedAt: task.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+// setTaskVersion records the version of task in private state.
+func setTaskVersion(ctx context.Context, private privateStateSetter, task *Task) diag.Diagnostics {
+	value, err := json.Marshal(newTaskVersion(task))
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode task version, got error: %s", err))
+		return diags
+	}
+
+	return private.SetKey(ctx, privateKeyTaskVersion, value)
+}
+
+// getTaskVersion returns the version recorded in private state. Resources
+// created before versions were tracked yield an empty version.
+func getTaskVersion(ctx context.Context, private privateStateGetter) (taskVersion, diag.Diagnostics) {
+	var version taskVersion
+
+	value, diags := private.GetKey(ctx, privateKeyTaskVersion)
+	if diags.HasError() || len(value) == 0 {
+		return version, diags
+	}
+
+	if err := json.Unmarshal(value, &version); err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to decode task version, got error: %s", err))
+	}
+
+	return version, diags
+}
+
+// ifMatch returns the If-Match value to send when writing task id. Without an
+// ETag it compares updated_at against the server instead, returning
+// ErrPreconditionFailed if the task has moved on.
+func (r *TaskResource) ifMatch(ctx context.Context, id int, version taskVersion) (string, error) {
+	if version.ETag != "" {
+		return version.ETag, nil
+	}
+
+	if version.UpdatedAt == "" {
+		return "", nil
+	}
+
+	task, err := r.client.GetTask(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if task.UpdatedAt.Format(time.RFC3339Nano) != version.UpdatedAt {
+		return "", fmt.Errorf("task with ID %d: %w", id, ErrPreconditionFailed)
+	}
+
+	return "", nil
+}
+
+// addWriteError reports a failed write, calling out concurrent modification
+// and statuses the task's workflow rejects separately so users know what to
+// change.
+func addWriteError(diags *diag.Diagnostics, action string, err error) {
+	if errors.Is(err, ErrPreconditionFailed) {
+		diags.AddError(
+			"Task Modified Outside Terraform",
+			fmt.Sprintf("Unable to %s task: it was changed in TaskMate after Terraform last read it. "+
+				"Run terraform plan again to review the remote changes, then re-apply. Got error: %s", action, err),
+		)
+		return
+	}
+
+	if errors.Is(err, ErrStatusNotAllowed) {
+		diags.AddError(
+			"Invalid Status Transition",
+			fmt.Sprintf("Unable to %s task: %s", action, err),
+		)
+		return
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("Unable to %s task, got error: %s", action, err))
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -23,8 +24,13 @@ type TasksDataSource struct {
 
 // TasksDataSourceModel describes the data source data model.
 type TasksDataSourceModel struct {
-	Tasks []TaskDataSourceModel `tfsdk:"tasks"`
-	ID    types.String          `tfsdk:"id"`
+	Tasks                 []TaskDataSourceModel `tfsdk:"tasks"`
+	Labels                types.Set             `tfsdk:"labels"`
+	ProjectID             types.Int64           `tfsdk:"project_id"`
+	Assignee              types.String          `tfsdk:"assignee"`
+	TotalEstimateMinutes  types.Int64           `tfsdk:"total_estimate_minutes"`
+	TotalTimeSpentMinutes types.Int64           `tfsdk:"total_time_spent_minutes"`
+	ID                    types.String          `tfsdk:"id"`
 }
 
 func (d *TasksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,8 +46,29 @@ func (d *TasksDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Placeholder identifier",
 				Computed:            true,
 			},
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "Only return tasks that carry all of these labels",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return tasks in this project",
+				Optional:            true,
+			},
+			"assignee": schema.StringAttribute{
+				MarkdownDescription: "Only return tasks assigned to this username",
+				Optional:            true,
+			},
+			"total_estimate_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Sum of the returned tasks' `estimate_minutes`",
+				Computed:            true,
+			},
+			"total_time_spent_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Sum of the returned tasks' `time_spent_minutes`",
+				Computed:            true,
+			},
 			"tasks": schema.ListNestedAttribute{
-				MarkdownDescription: "List of all tasks",
+				MarkdownDescription: "List of all tasks matching the filters",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -77,6 +104,50 @@ func (d *TasksDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 							MarkdownDescription: "Last update timestamp",
 							Computed:            true,
 						},
+						"labels": schema.SetAttribute{
+							MarkdownDescription: "Task labels",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"project_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the project the task belongs to, if any",
+							Computed:            true,
+						},
+						"workflow_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the workflow the task follows, if any",
+							Computed:            true,
+						},
+						"estimate_minutes": schema.Int64Attribute{
+							MarkdownDescription: "Estimated effort in minutes, if any",
+							Computed:            true,
+						},
+						"time_spent_minutes": schema.Int64Attribute{
+							MarkdownDescription: "Time logged against the task, in minutes",
+							Computed:            true,
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: "When the task was last moved to `completed`, if it is completed",
+							Computed:            true,
+						},
+						"assignee": schema.StringAttribute{
+							MarkdownDescription: "Username of the user the task is assigned to, if any",
+							Computed:            true,
+						},
+						"watchers": schema.SetAttribute{
+							MarkdownDescription: "Usernames of the users watching the task",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"blocked_by": schema.ListAttribute{
+							MarkdownDescription: "IDs of the tasks that block this task",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"blocking": schema.ListAttribute{
+							MarkdownDescription: "IDs of the tasks this task blocks",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -111,27 +182,70 @@ func (d *TasksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	tasks, err := d.client.ListTasks()
+	tasks, err := d.client.ListTasks(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tasks, got error: %s", err))
 		return
 	}
 
+	deps, err := d.client.ListDependencies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list task dependencies, got error: %s", err))
+		return
+	}
+
+	filter := TaskFilter{
+		Labels:    setLabels(data.Labels),
+		ProjectID: int(data.ProjectID.ValueInt64()),
+		Assignee:  data.Assignee.ValueString(),
+	}
+
 	// Convert tasks to data source model
-	data.Tasks = make([]TaskDataSourceModel, len(tasks))
-	for i, task := range tasks {
-		data.Tasks[i] = TaskDataSourceModel{
-			ID:          types.StringValue(fmt.Sprintf("%d", task.ID)),
-			Title:       types.StringValue(task.Title),
-			Description: types.StringValue(task.Description),
-			DueDate:     types.StringValue(task.DueDate),
-			Priority:    types.StringValue(task.Priority),
-			Status:      types.StringValue(task.Status),
-			CreatedAt:   types.StringValue(task.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
-			UpdatedAt:   types.StringValue(task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
+	data.Tasks = make([]TaskDataSourceModel, 0, len(tasks))
+	var totalEstimate, totalTimeSpent int64
+	for _, task := range tasks {
+		if !filter.Match(task) {
+			continue
 		}
+
+		totalEstimate += int64(task.EstimateMinutes)
+		totalTimeSpent += int64(task.TimeSpentMinutes)
+
+		blockedBy, blocking := taskBlockers(deps, task.ID)
+		blockedByList, diags := types.ListValueFrom(ctx, types.Int64Type, blockedBy)
+		resp.Diagnostics.Append(diags...)
+		blockingList, diags := types.ListValueFrom(ctx, types.Int64Type, blocking)
+		resp.Diagnostics.Append(diags...)
+
+		data.Tasks = append(data.Tasks, TaskDataSourceModel{
+			ID:               types.StringValue(strconv.Itoa(task.ID)),
+			Title:            types.StringValue(task.Title),
+			Description:      types.StringValue(task.Description),
+			DueDate:          types.StringValue(task.DueDate),
+			Priority:         types.StringValue(task.Priority),
+			Status:           types.StringValue(task.Status),
+			CreatedAt:        types.StringValue(task.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
+			UpdatedAt:        types.StringValue(task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
+			Labels:           labelSet(mergeLabels(task.Labels)),
+			ProjectID:        taskProjectID(task),
+			WorkflowID:       taskWorkflowID(task),
+			EstimateMinutes:  taskEstimate(task),
+			TimeSpentMinutes: types.Int64Value(int64(task.TimeSpentMinutes)),
+			CompletedAt:      taskCompletedAt(task),
+			Assignee:         taskAssignee(task),
+			Watchers:         labelSet(mergeLabels(task.Watchers)),
+			BlockedBy:        blockedByList,
+			Blocking:         blockingList,
+		})
 	}
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TotalEstimateMinutes = types.Int64Value(totalEstimate)
+	data.TotalTimeSpentMinutes = types.Int64Value(totalTimeSpent)
+
 	// Set a placeholder ID for the data source
 	data.ID = types.StringValue("tasks")
 
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &TasksToCSVFunction{}
+
+func NewTasksToCSVFunction() function.Function {
+	return &TasksToCSVFunction{}
+}
+
+// TasksToCSVFunction defines the tasks_to_csv function implementation.
+type TasksToCSVFunction struct{}
+
+func (f *TasksToCSVFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tasks_to_csv"
+}
+
+func (f *TasksToCSVFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Render tasks as CSV",
+		MarkdownDescription: "Renders a list of task objects, such as `data.taskmate_tasks.all.tasks`, as RFC 4180 CSV with a header row. `columns` names the task attributes to include, in order.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "tasks",
+				MarkdownDescription: "List of task objects",
+			},
+			function.ListParameter{
+				Name:                "columns",
+				MarkdownDescription: "Task attributes to include, for example `[\"id\", \"title\", \"due_date\"]`",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TasksToCSVFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tasks types.Dynamic
+	var columns []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tasks, &columns))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if len(columns) == 0 {
+		resp.Error = function.NewArgumentFuncError(1, "at least one column is required")
+		return
+	}
+
+	rows, err := taskRows(ctx, tasks)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.UseCRLF = true
+
+	records := [][]string{columns}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i] = row[column]
+		}
+		records = append(records, record)
+	}
+
+	if err := w.WriteAll(records); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, b.String()))
+}
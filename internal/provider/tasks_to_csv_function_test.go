@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTasksToCSVFunction(t *testing.T) {
+	taskType := map[string]attr.Type{
+		"id":     types.StringType,
+		"title":  types.StringType,
+		"labels": types.SetType{ElemType: types.StringType},
+	}
+	task := func(id, title string, labels ...string) attr.Value {
+		return types.ObjectValueMust(taskType, map[string]attr.Value{
+			"id":     types.StringValue(id),
+			"title":  types.StringValue(title),
+			"labels": labelSet(labels),
+		})
+	}
+
+	tests := []struct {
+		name    string
+		tasks   []attr.Value
+		columns []string
+		want    string
+	}{
+		{
+			name:    "plain fields",
+			tasks:   []attr.Value{task("1", "Deploy")},
+			columns: []string{"id", "title"},
+			want:    "id,title\r\n1,Deploy\r\n",
+		},
+		{
+			name:    "comma is quoted",
+			tasks:   []attr.Value{task("1", "Deploy, then verify")},
+			columns: []string{"title"},
+			want:    "title\r\n\"Deploy, then verify\"\r\n",
+		},
+		{
+			name:    "quotes are doubled",
+			tasks:   []attr.Value{task("1", `Say "done"`)},
+			columns: []string{"title"},
+			want:    "title\r\n\"Say \"\"done\"\"\"\r\n",
+		},
+		{
+			name:    "newline is quoted",
+			tasks:   []attr.Value{task("1", "line one\nline two")},
+			columns: []string{"title"},
+			want:    "title\r\n\"line one\r\nline two\"\r\n",
+		},
+		{
+			name:    "sets are joined sorted",
+			tasks:   []attr.Value{task("1", "Deploy", "ops", "backend")},
+			columns: []string{"labels"},
+			want:    "labels\r\n\"backend, ops\"\r\n",
+		},
+		{
+			name:    "missing column is empty",
+			tasks:   []attr.Value{task("1", "Deploy")},
+			columns: []string{"id", "due_date"},
+			want:    "id,due_date\r\n1,\r\n",
+		},
+		{
+			name:    "no tasks",
+			tasks:   []attr.Value{},
+			columns: []string{"id"},
+			want:    "id\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := types.DynamicValue(types.ListValueMust(types.ObjectType{AttrTypes: taskType}, tt.tasks))
+			columns := make([]attr.Value, len(tt.columns))
+			for i, column := range tt.columns {
+				columns[i] = types.StringValue(column)
+			}
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{tasks, types.ListValueMust(types.StringType, columns)}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewTasksToCSVFunction().Run(context.Background(), req, &resp)
+
+			if resp.Error != nil {
+				t.Fatalf("Run returned error: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("Run = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &TasksToICalFunction{}
+
+func NewTasksToICalFunction() function.Function {
+	return &TasksToICalFunction{}
+}
+
+// TasksToICalFunction defines the tasks_to_ical function implementation.
+type TasksToICalFunction struct{}
+
+func (f *TasksToICalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tasks_to_ical"
+}
+
+func (f *TasksToICalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Render task due dates as an iCalendar feed",
+		MarkdownDescription: "Renders a list of task objects, such as `data.taskmate_tasks.all.tasks`, as an RFC 5545 calendar with one all-day `VEVENT` per task due date. Tasks without a due date are skipped. The output is deterministic: `DTSTAMP` is taken from the task's `updated_at`.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "tasks",
+				MarkdownDescription: "List of task objects",
+			},
+			function.StringParameter{
+				Name:                "calendar_name",
+				MarkdownDescription: "Calendar display name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TasksToICalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tasks types.Dynamic
+	var calendarName string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tasks, &calendarName))
+
+	if resp.Error != nil {
+		return
+	}
+
+	rows, err := taskRows(ctx, tasks)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var b strings.Builder
+
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:-//TaskMate//terraform-provider-taskmate//EN")
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "X-WR-CALNAME:"+icalText(calendarName))
+
+	for i, row := range rows {
+		if row["due_date"] == "" {
+			continue
+		}
+
+		due, err := parseDueDate(row["due_date"])
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "task "+row["id"]+": "+err.Error())
+			return
+		}
+
+		// DTSTAMP is required; use the task's own timestamps so the output
+		// only changes when the task does.
+		stamp := due
+		for _, field := range []string{"updated_at", "created_at"} {
+			if t, err := time.Parse(time.RFC3339, row[field]); err == nil {
+				stamp = t
+				break
+			}
+		}
+
+		uid := row["id"]
+		if uid == "" {
+			uid = "index-" + strconv.Itoa(i)
+		}
+
+		writeICalLine(&b, "BEGIN:VEVENT")
+		writeICalLine(&b, "UID:task-"+icalText(uid)+"@taskmate")
+		writeICalLine(&b, "DTSTAMP:"+stamp.UTC().Format("20060102T150405Z"))
+		writeICalLine(&b, "DTSTART;VALUE=DATE:"+due.Format("20060102"))
+		writeICalLine(&b, "DTEND;VALUE=DATE:"+due.AddDate(0, 0, 1).Format("20060102"))
+		writeICalLine(&b, "SUMMARY:"+icalText(row["title"]))
+		if row["description"] != "" {
+			writeICalLine(&b, "DESCRIPTION:"+icalText(row["description"]))
+		}
+		writeICalLine(&b, "END:VEVENT")
+	}
+
+	writeICalLine(&b, "END:VCALENDAR")
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, b.String()))
+}
+
+var icalTextReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// icalText escapes a TEXT property value (RFC 5545 section 3.3.11).
+func icalText(s string) string {
+	return icalTextReplacer.Replace(s)
+}
+
+// writeICalLine writes a content line terminated by CRLF, folding it so no
+// line exceeds 75 octets (RFC 5545 section 3.1). Folds never split a UTF-8
+// sequence.
+func writeICalLine(b *strings.Builder, line string) {
+	const limit = 75
+
+	width := limit
+	for len(line) > width {
+		cut := width
+		for cut > 0 && !isUTF8Start(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards the limit.
+		width = limit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isUTF8Start(c byte) bool {
+	return c&0xC0 != 0x80
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestICalText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Deploy", "Deploy"},
+		{"a;b,c", `a\;b\,c`},
+		{`C:\temp`, `C:\\temp`},
+		{"one\ntwo\r\nthree\rfour", `one\ntwo\nthree\nfour`},
+		{`already \n escaped`, `already \\n escaped`},
+	}
+
+	for _, tt := range tests {
+		if got := icalText(tt.in); got != tt.want {
+			t.Errorf("icalText(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWriteICalLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "short",
+			line: "SUMMARY:Deploy",
+			want: "SUMMARY:Deploy\r\n",
+		},
+		{
+			name: "exactly 75 octets",
+			line: strings.Repeat("a", 75),
+			want: strings.Repeat("a", 75) + "\r\n",
+		},
+		{
+			name: "76 octets",
+			line: strings.Repeat("a", 76),
+			want: strings.Repeat("a", 75) + "\r\n a\r\n",
+		},
+		{
+			name: "continuation lines count the leading space",
+			line: strings.Repeat("a", 75+74+1),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n",
+		},
+		{
+			name: "multi-byte character is not split",
+			line: strings.Repeat("a", 74) + "é",
+			want: strings.Repeat("a", 74) + "\r\n é\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			writeICalLine(&b, tt.line)
+			if got := b.String(); got != tt.want {
+				t.Errorf("writeICalLine = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteICalLineUnfolds(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("Überprüfung der Zertifikate – ", 12)
+
+	var b strings.Builder
+	writeICalLine(&b, line)
+	folded := b.String()
+
+	if !strings.HasSuffix(folded, "\r\n") {
+		t.Fatalf("folded line %q does not end with CRLF", folded)
+	}
+	for _, physical := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+		if len(physical) > 75 {
+			t.Errorf("physical line is %d octets, want at most 75: %q", len(physical), physical)
+		}
+		if !utf8.ValidString(physical) {
+			t.Errorf("physical line splits a UTF-8 sequence: %q", physical)
+		}
+	}
+
+	if unfolded := strings.ReplaceAll(strings.TrimSuffix(folded, "\r\n"), "\r\n ", ""); unfolded != line {
+		t.Errorf("unfolded line = %q, want %q", unfolded, line)
+	}
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &TasksToMarkdownFunction{}
+
+// markdownColumns are the task attributes rendered by tasks_to_markdown, with
+// their headings.
+var markdownColumns = [][2]string{
+	{"id", "ID"},
+	{"title", "Title"},
+	{"status", "Status"},
+	{"priority", "Priority"},
+	{"due_date", "Due Date"},
+}
+
+func NewTasksToMarkdownFunction() function.Function {
+	return &TasksToMarkdownFunction{}
+}
+
+// TasksToMarkdownFunction defines the tasks_to_markdown function implementation.
+type TasksToMarkdownFunction struct{}
+
+func (f *TasksToMarkdownFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tasks_to_markdown"
+}
+
+func (f *TasksToMarkdownFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Render tasks as a Markdown table",
+		MarkdownDescription: "Renders a list of task objects, such as `data.taskmate_tasks.all.tasks`, as a Markdown table with ID, title, status, priority and due date columns. Pipes and line breaks in values are escaped.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "tasks",
+				MarkdownDescription: "List of task objects",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TasksToMarkdownFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tasks types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tasks))
+
+	if resp.Error != nil {
+		return
+	}
+
+	rows, err := taskRows(ctx, tasks)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var b strings.Builder
+
+	b.WriteString("|")
+	for _, column := range markdownColumns {
+		b.WriteString(" " + column[1] + " |")
+	}
+	b.WriteString("\n|")
+	for range markdownColumns {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+
+	for _, row := range rows {
+		b.WriteString("|")
+		for _, column := range markdownColumns {
+			b.WriteString(" " + markdownCell(row[column[0]]) + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, b.String()))
+}
+
+var markdownCellReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+	"\r", "<br>",
+)
+
+// markdownCell escapes a value for use inside a Markdown table cell.
+func markdownCell(s string) string {
+	return markdownCellReplacer.Replace(s)
+}
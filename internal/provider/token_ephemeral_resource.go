@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &TokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &TokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &TokenEphemeralResource{}
+
+// privateKeyToken is the private data key holding the issued token, so Close
+// can revoke it.
+const privateKeyToken = "token"
+
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &TokenEphemeralResource{}
+}
+
+// TokenEphemeralResource defines the ephemeral resource implementation.
+type TokenEphemeralResource struct {
+	client *Client
+}
+
+// TokenEphemeralResourceModel describes the ephemeral resource data model.
+type TokenEphemeralResourceModel struct {
+	Scopes    types.List   `tfsdk:"scopes"`
+	TTL       types.String `tfsdk:"ttl"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (r *TokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+func (r *TokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Short-lived TaskMate API token
+
+Issues an API token for the duration of a Terraform run. The token is never
+written to plan or state, and is revoked when Terraform is done with it if the
+server supports revocation.
+
+## Example Usage
+
+` + "```hcl" + `
+ephemeral "taskmate_token" "ci" {
+  scopes = ["tasks:write"]
+  ttl    = "15m"
+}
+
+provider "taskmate" {
+  alias = "scoped"
+  token = ephemeral.taskmate_token.ci.token
+}
+` + "```" + `
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes to request for the token. Defaults to the server's default scopes",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Requested token lifetime as a duration, such as `15m` or `1h`",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The issued API token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry timestamp reported by the server, if any",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tokenReq TokenRequest
+
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &tokenReq.Scopes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TTL.IsNull() {
+		ttl, err := time.ParseDuration(data.TTL.ValueString())
+		if err != nil || ttl <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ttl"),
+				"Invalid TTL",
+				fmt.Sprintf("ttl must be a positive duration such as \"15m\", got %q", data.TTL.ValueString()),
+			)
+			return
+		}
+		tokenReq.TTL = int64(ttl / time.Second)
+	}
+
+	token, err := r.client.CreateToken(ctx, tokenReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create token, got error: %s", err))
+		return
+	}
+
+	data.Token = types.StringValue(token.Token)
+	data.ExpiresAt = types.StringNull()
+	if token.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(token.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
+	}
+
+	private, err := json.Marshal(token)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode token, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyToken, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *TokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, privateKeyToken)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || len(private) == 0 {
+		return
+	}
+
+	var token APIToken
+	if err := json.Unmarshal(private, &token); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode token, got error: %s", err))
+		return
+	}
+
+	err := r.client.RevokeToken(ctx, &token)
+	if errors.Is(err, ErrRevocationUnsupported) {
+		// Nothing to do; the token simply lives until it expires.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke token, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// userByUsername returns the user with the given username, or nil if there
+// is none.
+func userByUsername(users []*User, username string) *User {
+	for _, user := range users {
+		if user.Username == username {
+			return user
+		}
+	}
+
+	return nil
+}
+
+// userByEmail returns the single user with the given email address, ignoring
+// case.
+func userByEmail(users []*User, email string) (*User, error) {
+	var matches []*User
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			matches = append(matches, user)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no user with email %q", email)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d users have email %q; look the user up by username instead", len(matches), email)
+	}
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkflowResource{}
+var _ resource.ResourceWithImportState = &WorkflowResource{}
+var _ resource.ResourceWithValidateConfig = &WorkflowResource{}
+
+func NewWorkflowResource() resource.Resource {
+	return &WorkflowResource{}
+}
+
+// WorkflowResource defines the resource implementation.
+type WorkflowResource struct {
+	client *Client
+}
+
+// WorkflowResourceModel describes the resource data model.
+type WorkflowResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Statuses      types.List   `tfsdk:"statuses"`
+	InitialStatus types.String `tfsdk:"initial_status"`
+	Transitions   types.Map    `tfsdk:"transitions"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+func (r *WorkflowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `TaskMate workflow resource
+
+Defines the statuses a task can be in and the transitions allowed between them.
+Tasks follow a workflow through their ` + "`workflow_id`" + ` attribute, and a plan that
+moves such a task to a status the workflow does not allow from its current one
+fails, listing the permitted next statuses.
+
+` + "`transitions`" + ` maps each status to the statuses a task may move to from it.
+Statuses without an entry are final.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "taskmate_workflow" "delivery" {
+  name     = "Delivery"
+  statuses = ["pending", "in_progress", "blocked", "in_review", "completed"]
+
+  transitions = {
+    pending     = ["in_progress"]
+    in_progress = ["blocked", "in_review"]
+    blocked     = ["in_progress"]
+    in_review   = ["in_progress", "completed"]
+  }
+}
+
+resource "taskmate_task" "deploy" {
+  title       = "Deploy"
+  workflow_id = taskmate_workflow.delivery.id
+  status      = "in_progress"
+}
+` + "```" + `
+
+## Import
+
+` + "```bash" + `
+terraform import taskmate_workflow.delivery 2
+` + "```" + `
+`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Workflow identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Workflow name",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"statuses": schema.ListAttribute{
+				MarkdownDescription: "Statuses a task following the workflow can be in, in display order",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"initial_status": schema.StringAttribute{
+				MarkdownDescription: "Status of new tasks. Defaults to the first of `statuses`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transitions": schema.MapAttribute{
+				MarkdownDescription: "Statuses a task may move to, keyed by the status it moves from. Statuses without an entry are final",
+				ElementType:         transitionsElementType,
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(setvalidator.SizeAtLeast(1)),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the initial status and every transition refer
+// to one of the workflow's statuses.
+func (r *WorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Statuses.IsUnknown() || data.Statuses.IsNull() {
+		return
+	}
+
+	var statuses []string
+	for _, element := range data.Statuses.Elements() {
+		status, ok := element.(types.String)
+		if !ok || status.IsUnknown() {
+			return
+		}
+		statuses = append(statuses, status.ValueString())
+	}
+
+	check := func(attrPath path.Path, status types.String) {
+		if status.IsNull() || status.IsUnknown() || slices.Contains(statuses, status.ValueString()) {
+			return
+		}
+		resp.Diagnostics.AddAttributeError(
+			attrPath,
+			"Unknown Workflow Status",
+			fmt.Sprintf("%q is not one of the workflow's statuses: %s.", status.ValueString(), formatStatuses(statuses)),
+		)
+	}
+
+	check(path.Root("initial_status"), data.InitialStatus)
+
+	if data.Transitions.IsNull() || data.Transitions.IsUnknown() {
+		return
+	}
+
+	for from, element := range data.Transitions.Elements() {
+		if !slices.Contains(statuses, from) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transitions").AtMapKey(from),
+				"Unknown Workflow Status",
+				fmt.Sprintf("%q is not one of the workflow's statuses: %s.", from, formatStatuses(statuses)),
+			)
+		}
+
+		targets, ok := element.(types.Set)
+		if !ok || targets.IsUnknown() || targets.IsNull() {
+			continue
+		}
+		for _, target := range targets.Elements() {
+			if to, ok := target.(types.String); ok {
+				check(path.Root("transitions").AtMapKey(from).AtSetValue(to), to)
+			}
+		}
+	}
+}
+
+func (r *WorkflowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := workflowInput(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.CreateWorkflow(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setWorkflowResourceModel(ctx, &data, workflow)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.GetWorkflow(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setWorkflowResourceModel(ctx, &data, workflow)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := workflowInput(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.UpdateWorkflow(ctx, int(data.ID.ValueInt64()), input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setWorkflowResourceModel(ctx, &data, workflow)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWorkflow(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow, got error: %s", err))
+		return
+	}
+}
+
+func (r *WorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil || id <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Workflow ID must be a positive integer, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// workflowInput returns the API request body for the planned workflow.
+func workflowInput(ctx context.Context, data WorkflowResourceModel) (WorkflowInput, diag.Diagnostics) {
+	input := WorkflowInput{
+		Name:          data.Name.ValueString(),
+		InitialStatus: data.InitialStatus.ValueString(),
+		Transitions:   workflowTransitions(data.Transitions),
+	}
+
+	diags := data.Statuses.ElementsAs(ctx, &input.Statuses, false)
+
+	return input, diags
+}
+
+// setWorkflowResourceModel copies the API's view of a workflow into data.
+func setWorkflowResourceModel(ctx context.Context, data *WorkflowResourceModel, workflow *Workflow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.Int64Value(int64(workflow.ID))
+	data.Name = types.StringValue(workflow.Name)
+	data.Statuses, diags = types.ListValueFrom(ctx, types.StringType, workflow.Statuses)
+	data.InitialStatus = types.StringValue(workflow.InitialStatus)
+	data.Transitions = transitionsValue(workflow.Transitions)
+	data.CreatedAt = types.StringValue(workflow.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(workflow.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+
+	return diags
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// transitionsElementType is the element type of a workflow's transitions
+// map: the set of statuses a task may move to from the key status.
+var transitionsElementType = types.SetType{ElemType: types.StringType}
+
+// transitionsValue converts workflow transitions into a known map value.
+// Statuses without targets are left out; they are final either way.
+func transitionsValue(transitions []WorkflowTransition) types.Map {
+	targets := make(map[string][]string)
+	for _, transition := range transitions {
+		targets[transition.From] = append(targets[transition.From], transition.To...)
+	}
+
+	elements := make(map[string]attr.Value, len(targets))
+	for from, to := range targets {
+		if len(to) == 0 {
+			continue
+		}
+		elements[from] = labelSet(mergeLabels(to))
+	}
+
+	return types.MapValueMust(transitionsElementType, elements)
+}
+
+// workflowTransitions returns the transitions held in a known map value,
+// ordered by source status.
+func workflowTransitions(transitions types.Map) []WorkflowTransition {
+	result := []WorkflowTransition{}
+	if transitions.IsNull() || transitions.IsUnknown() {
+		return result
+	}
+
+	for from, element := range transitions.Elements() {
+		to, ok := element.(types.Set)
+		if !ok {
+			continue
+		}
+		result = append(result, WorkflowTransition{From: from, To: setLabels(to)})
+	}
+	slices.SortFunc(result, func(a, b WorkflowTransition) int {
+		return cmp.Compare(a.From, b.From)
+	})
+
+	return result
+}
+
+// nextStatuses returns the statuses workflow lets a task move to from
+// status, in the workflow's status order.
+func nextStatuses(workflow *Workflow, status string) []string {
+	var next []string
+	for _, transition := range workflow.Transitions {
+		if transition.From != status {
+			continue
+		}
+		for _, to := range transition.To {
+			if !slices.Contains(next, to) {
+				next = append(next, to)
+			}
+		}
+	}
+
+	order := func(s string) int {
+		if i := slices.Index(workflow.Statuses, s); i >= 0 {
+			return i
+		}
+		return len(workflow.Statuses)
+	}
+	slices.SortStableFunc(next, func(a, b string) int {
+		return order(a) - order(b)
+	})
+
+	return next
+}
+
+// finalStatuses returns the statuses workflow lets a task move on from to no
+// other, in the workflow's status order.
+func finalStatuses(workflow *Workflow) []string {
+	var final []string
+	for _, status := range workflow.Statuses {
+		if len(nextStatuses(workflow, status)) == 0 {
+			final = append(final, status)
+		}
+	}
+	return final
+}
+
+// initialStatus returns the status new tasks in workflow start in: its
+// initial status or, if the server reports none, its first status.
+func initialStatus(workflow *Workflow) string {
+	if workflow.InitialStatus != "" || len(workflow.Statuses) == 0 {
+		return workflow.InitialStatus
+	}
+	return workflow.Statuses[0]
+}
+
+// ErrStatusNotAllowed is returned when a task's workflow does not let it take
+// the status the provider is about to set.
+var ErrStatusNotAllowed = errors.New("status not allowed by workflow")
+
+// checkWorkflowStatus returns an error wrapping ErrStatusNotAllowed if
+// workflow does not let a task move from current to status. It follows the
+// rules checkTransition applies at plan time: a task whose current status is
+// not part of the workflow may take any of its statuses.
+func checkWorkflowStatus(workflow *Workflow, current, status string) error {
+	if !slices.Contains(workflow.Statuses, status) {
+		return fmt.Errorf("%w: %q is not a status of workflow %q; its statuses are: %s",
+			ErrStatusNotAllowed, status, workflow.Name, formatStatuses(workflow.Statuses))
+	}
+
+	if current == status || !slices.Contains(workflow.Statuses, current) {
+		return nil
+	}
+
+	next := nextStatuses(workflow, current)
+	if slices.Contains(next, status) {
+		return nil
+	}
+
+	if len(next) == 0 {
+		return fmt.Errorf("%w: workflow %q does not allow moving a task from %q to %q: %q is a final status",
+			ErrStatusNotAllowed, workflow.Name, current, status, current)
+	}
+
+	return fmt.Errorf("%w: workflow %q does not allow moving a task from %q to %q; permitted next statuses: %s",
+		ErrStatusNotAllowed, workflow.Name, current, status, formatStatuses(next))
+}
+
+// formatStatuses lists statuses for diagnostics.
+func formatStatuses(statuses []string) string {
+	quoted := make([]string, len(statuses))
+	for i, status := range statuses {
+		quoted[i] = strconv.Quote(status)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// delivery is a workflow without a "pending" status, where "done" and
+// "archived" are final and "archived" can only be reached from "todo".
+var delivery = &Workflow{
+	ID:       1,
+	Name:     "Delivery",
+	Statuses: []string{"todo", "doing", "review", "done", "archived"},
+	Transitions: []WorkflowTransition{
+		{From: "todo", To: []string{"doing", "archived"}},
+		{From: "doing", To: []string{"review", "todo"}},
+		{From: "review", To: []string{"done", "doing"}},
+	},
+}
+
+func TestNextStatuses(t *testing.T) {
+	tests := []struct {
+		status string
+		want   []string
+	}{
+		{status: "todo", want: []string{"doing", "archived"}},
+		{status: "doing", want: []string{"todo", "review"}},
+		{status: "review", want: []string{"doing", "done"}},
+		{status: "done", want: nil},
+		{status: "unknown", want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := nextStatuses(delivery, tt.status); !slices.Equal(got, tt.want) {
+			t.Errorf("nextStatuses(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestFinalStatuses(t *testing.T) {
+	if got := finalStatuses(delivery); !slices.Equal(got, []string{"done", "archived"}) {
+		t.Errorf("finalStatuses = %v, want [done archived]", got)
+	}
+}
+
+func TestInitialStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow *Workflow
+		want     string
+	}{
+		{name: "set", workflow: &Workflow{Statuses: []string{"todo", "doing"}, InitialStatus: "doing"}, want: "doing"},
+		{name: "first status", workflow: &Workflow{Statuses: []string{"todo", "doing"}}, want: "todo"},
+		{name: "no statuses", workflow: &Workflow{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := initialStatus(tt.workflow); got != tt.want {
+			t.Errorf("%s: initialStatus = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckWorkflowStatus(t *testing.T) {
+	tests := []struct {
+		current string
+		status  string
+		wantErr string
+	}{
+		{current: "todo", status: "doing"},
+		{current: "todo", status: "archived"},
+		{current: "done", status: "done"},
+		{current: "pending", status: "archived"},
+		{current: "todo", status: "completed", wantErr: `"completed" is not a status of workflow "Delivery"`},
+		{current: "review", status: "archived", wantErr: `permitted next statuses: "doing", "done"`},
+		{current: "done", status: "archived", wantErr: `"done" is a final status`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+" to "+tt.status, func(t *testing.T) {
+			err := checkWorkflowStatus(delivery, tt.current, tt.status)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("checkWorkflowStatus returned error: %s", err)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("checkWorkflowStatus succeeded, want error containing %q", tt.wantErr)
+			case tt.wantErr != "" && (!errors.Is(err, ErrStatusNotAllowed) || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("checkWorkflowStatus error = %q, want ErrStatusNotAllowed containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      TaskResourceModel
+		status    string
+		wantPatch bool
+	}{
+		{
+			name:      "no workflow",
+			data:      TaskResourceModel{Status: types.StringValue("pending"), WorkflowID: types.Int64Null()},
+			status:    "archived",
+			wantPatch: true,
+		},
+		{
+			name:      "allowed by the workflow",
+			data:      TaskResourceModel{Status: types.StringValue("todo"), WorkflowID: types.Int64Value(1)},
+			status:    "archived",
+			wantPatch: true,
+		},
+		{
+			name:   "not a workflow status",
+			data:   TaskResourceModel{Status: types.StringValue("todo"), WorkflowID: types.Int64Value(1)},
+			status: "completed",
+		},
+		{
+			name:   "not reachable",
+			data:   TaskResourceModel{Status: types.StringValue("done"), WorkflowID: types.Int64Value(1)},
+			status: "archived",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patched := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch route := strings.TrimPrefix(r.URL.Path, "/api/v1"); {
+				case r.Method == http.MethodGet && route == "/workflows/1":
+					_ = json.NewEncoder(w).Encode(delivery)
+				case r.Method == http.MethodPatch && route == "/tasks/3":
+					patched = true
+					_ = json.NewEncoder(w).Encode(Task{ID: 3, Status: tt.status})
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			r := &TaskResource{client: NewClient(server.URL, "token")}
+			err := r.setStatus(context.Background(), 3, "", tt.data, tt.status)
+
+			if tt.wantPatch && err != nil {
+				t.Fatalf("setStatus returned error: %s", err)
+			}
+			if !tt.wantPatch && !errors.Is(err, ErrStatusNotAllowed) {
+				t.Fatalf("setStatus error = %v, want ErrStatusNotAllowed", err)
+			}
+			if patched != tt.wantPatch {
+				t.Errorf("patched = %t, want %t", patched, tt.wantPatch)
+			}
+		})
+	}
+}